@@ -1,81 +1,186 @@
 package main
 
 import (
-	"io/ioutil"
+	"fmt"
 	"log"
 	"os"
 	"path/filepath"
 	"syscall"
-	"text/template"
 
 	flags "github.com/jessevdk/go-flags"
+	"github.com/kaishuu0123/erd-go/erd"
+	"github.com/kaishuu0123/erd-go/filter"
+	"github.com/kaishuu0123/erd-go/importer"
+	"github.com/kaishuu0123/erd-go/render"
 	"golang.org/x/crypto/ssh/terminal"
 )
 
 type Options struct {
-	OutFormat  string `short:"f" long:"fmt" description:"output format (dot only)"`
-	InputFile  string `short:"i" long:"input" description:"input will be read from the given file."`
-	OutputFile string `short:"o" long:"output" description:"output will be written to the given file."`
+	OutFormat   string   `short:"f" long:"fmt" description:"output format, see --list-formats (default: dot, er for import)"`
+	ListFormats bool     `long:"list-formats" description:"list the available formats and exit"`
+	InputFile   string   `short:"i" long:"input" description:"input will be read from the given file."`
+	OutputFile  string   `short:"o" long:"output" description:"output will be written to the given file."`
+	Dialect     string   `long:"dialect" description:"SQL dialect of --fmt sql" choice:"postgres" choice:"mysql" choice:"sqlite" default:"postgres"`
+	DPI         float64  `long:"dpi" description:"resolution of --fmt png" default:"96"`
+	TemplateDir string   `long:"template-dir" description:"directory of *.tmpl files overriding or extending the templates of --fmt"`
+	Template    string   `long:"template" description:"name of the template to execute instead of the one of --fmt"`
+	Focus       []string `long:"focus" description:"draw only the tables matching NAME, a glob, group:NAME or tag:NAME, and their neighbors; repeatable" value-name:"PATTERN"`
+	Depth       int      `long:"depth" description:"number of relations to follow from the --focus tables" default:"1"`
+	Stubs       bool     `long:"stubs" description:"draw the tables one relation beyond --depth without their columns"`
+	SplitBy     string   `long:"split-by" description:"write a file per group or connected component, and an index.md, into the --output directory" choice:"group" choice:"component"`
+	DiagFormat  string   `long:"diagnostics" description:"format of the errors written to stderr" choice:"text" choice:"json" default:"text"`
+}
+
+type ImportCommand struct {
+	From string `long:"from" description:"input format, see import --list-formats" default:"sql"`
 }
 
 var opts Options
+var importCommand ImportCommand
+
+// writeDiagnostics writes ds to stderr in the --diagnostics format.
+func writeDiagnostics(ds []*erd.Diagnostic) error {
+	if opts.DiagFormat == "json" {
+		return erd.WriteDiagnosticsJSON(os.Stderr, ds)
+	}
+	return erd.WriteDiagnostics(os.Stderr, ds)
+}
 
 func main() {
-	contents := ""
 	logStderr := log.New(os.Stderr, "", 0)
 
 	optsParser := flags.NewParser(&opts, flags.Default)
 	optsParser.Name = filepath.Base(os.Args[0])
-	optsParser.Usage = "[OPTIONS] PATTERN [PATH]"
+	optsParser.Usage = "[OPTIONS] [FILE...]"
+	optsParser.SubcommandsOptional = true
+	optsParser.AddCommand("import",
+		"Convert a schema from another format",
+		"Reads a schema in the --from format and writes it as --fmt, which defaults to the erd-go format.",
+		&importCommand)
+	optsParser.AddCommand("fmt",
+		"Rewrite schemas in canonical form",
+		"Formats the given .er files, or stdin when none or - is given, and writes them to stdout. Comments are kept.",
+		&fmtCommand)
 
 	args, err := optsParser.Parse()
 	if err != nil {
 		logStderr.Println(err)
 		os.Exit(1)
 	}
+	importing := optsParser.Active != nil && optsParser.Active.Name == "import"
+	if optsParser.Active != nil && optsParser.Active.Name == "fmt" {
+		os.Exit(runFmt())
+	}
 
-	if terminal.IsTerminal(int(syscall.Stdin)) {
-		if len(args) == 0 && opts.InputFile == "" {
-			optsParser.WriteHelp(os.Stdout)
-			os.Exit(1)
+	if opts.ListFormats {
+		if importing {
+			for _, f := range importer.Formats() {
+				fmt.Printf("%-10s %s\n", f.Name, f.Description)
+			}
+			return
 		}
-		buffer, err := ioutil.ReadFile(opts.InputFile)
-		if err != nil {
-			logStderr.Println(err)
-			os.Exit(1)
+		for _, f := range render.Formats() {
+			fmt.Printf("%-10s %s\n", f.Name, f.Description)
 		}
-		contents = string(buffer)
-	} else {
-		body, err := ioutil.ReadAll(os.Stdin)
+		return
+	}
+
+	var from *importer.Format
+	if importing {
+		from, err = importer.Lookup(importCommand.From)
 		if err != nil {
 			logStderr.Println(err)
 			os.Exit(1)
 		}
-		contents = string(body)
+		if opts.OutFormat == "" {
+			opts.OutFormat = "er"
+		}
+	}
+	if opts.OutFormat == "" {
+		opts.OutFormat = "dot"
+	}
+
+	format, err := render.Lookup(opts.OutFormat)
+	if err != nil {
+		logStderr.Println(err)
+		os.Exit(1)
+	}
+	if format.Templates == nil && (opts.TemplateDir != "" || opts.Template != "") {
+		logStderr.Printf("--fmt %s does not use templates\n", format.Name)
+		os.Exit(1)
+	}
+	if opts.Stubs && !format.Stubs {
+		logStderr.Printf("--fmt %s cannot draw --stubs\n", format.Name)
+		os.Exit(1)
+	}
+	if opts.SplitBy != "" && opts.OutputFile == "" {
+		logStderr.Println("--split-by needs --output, the directory to write the files to")
+		os.Exit(1)
 	}
 
-	parser := &Parser{Buffer: contents}
-	parser.Init()
-	err = parser.Parse()
+	names, err := inputs(opts.InputFile, args, terminal.IsTerminal(int(syscall.Stdin)))
+	if err != nil {
+		logStderr.Println(err)
+		os.Exit(1)
+	}
+	if len(names) == 0 {
+		optsParser.WriteHelp(os.Stdout)
+		os.Exit(1)
+	}
+	files, err := readFiles(names, os.Stdin)
 	if err != nil {
 		logStderr.Println(err)
 		os.Exit(1)
 	}
 
-	parser.Execute()
+	var model *erd.Erd
+	if importing {
+		if len(files) > 1 {
+			logStderr.Println("import reads a single file")
+			os.Exit(1)
+		}
+		model, err = from.Import(files[0].Name, files[0].Text)
+	} else {
+		model, err = erd.LoadFiles(files)
+	}
+	if model == nil {
+		logStderr.Println(err)
+		os.Exit(1)
+	}
+
+	if err := writeDiagnostics(model.Diagnostics); err != nil {
+		logStderr.Println(err)
+		os.Exit(1)
+	}
 
-	if parser.Erd.IsError {
+	if model.IsError {
 		os.Exit(1)
 	}
 
-	dot, _ := Asset("templates/dot.tmpl")
-	tables, _ := Asset("templates/dot_tables.tmpl")
-	relations, _ := Asset("templates/dot_relations.tmpl")
-	templates := template.Must(
-		template.New("").Parse(
-			string(dot) +
-				string(tables) +
-				string(relations)))
+	if len(opts.Focus) > 0 {
+		if err := filter.Apply(model, filter.Options{
+			Focus: opts.Focus,
+			Depth: opts.Depth,
+			Stubs: opts.Stubs,
+		}); err != nil {
+			logStderr.Println(err)
+			os.Exit(1)
+		}
+	}
+
+	ropts := render.Options{
+		Dialect:     opts.Dialect,
+		DPI:         opts.DPI,
+		TemplateDir: opts.TemplateDir,
+		Template:    opts.Template,
+	}
+	if opts.SplitBy != "" {
+		if err := writeSplit(opts.OutputFile, model, opts.SplitBy, format, ropts); err != nil {
+			logStderr.Println(err)
+			os.Exit(1)
+		}
+		return
+	}
 
 	fd := os.Stdout
 	if opts.OutputFile != "" {
@@ -86,5 +191,8 @@ func main() {
 		}
 	}
 
-	templates.ExecuteTemplate(fd, "dot", parser.Erd)
+	if err := format.Render(fd, model, ropts); err != nil {
+		logStderr.Println(err)
+		os.Exit(1)
+	}
 }
@@ -0,0 +1,74 @@
+package erd
+
+// The functions of this file build a model without the parser, for the
+// packages that read schemas in other formats. Positions are rune offsets
+// into the source the element was read from, used by Validate to locate its
+// diagnostics; 0 will do when there is no such source. Relations are
+// appended to Relations directly.
+
+// NewGroup adds a group with the attributes attrs, which may be nil, and
+// returns it. Validate reports a group added twice.
+func (e *Erd) NewGroup(title string, attrs map[string]string, pos int) *Group {
+	if e.groupIndex == nil {
+		e.groupIndex = map[string]*Group{}
+	}
+	if attrs == nil {
+		attrs = map[string]string{}
+	}
+	group := &Group{Title: title, GroupAttributes: attrs, Pos: pos}
+	if _, ok := e.groupIndex[title]; ok {
+		e.duplicateGroups = append(e.duplicateGroups, group)
+	} else {
+		e.Groups = append(e.Groups, group)
+		e.groupIndex[title] = group
+	}
+	return group
+}
+
+// NewTable adds a table with the attributes attrs, which may be nil, and
+// returns it. Validate reports a table added twice.
+func (e *Erd) NewTable(title string, attrs map[string]string, pos int) *Table {
+	if e.tableIndex == nil {
+		e.tableIndex = map[string]*Table{}
+	}
+	if attrs == nil {
+		attrs = map[string]string{}
+	}
+	table := &Table{Title: title, TableAttributes: attrs, Pos: pos}
+	if _, ok := e.tableIndex[title]; ok {
+		e.duplicateTables = append(e.duplicateTables, table)
+	} else {
+		e.Tables = append(e.Tables, table)
+		e.tableIndex[title] = table
+	}
+	return table
+}
+
+// AppendColumn adds c to the columns of t, and to its primary keys if
+// c.IsPrimaryKey is set.
+func (t *Table) AppendColumn(c Column) {
+	if c.ColumnAttributes == nil {
+		c.ColumnAttributes = map[string]string{}
+	}
+	t.Columns = append(t.Columns, c)
+	t.CurrentColumnId = len(t.Columns) - 1
+	if c.IsPrimaryKey {
+		t.PrimaryKeys = append(t.PrimaryKeys, t.CurrentColumnId)
+	}
+}
+
+// AppendIndex adds idx to the indexes of t. columnPos holds the positions
+// of the columns of idx, or is nil to locate them all at idx.Pos.
+func (t *Table) AppendIndex(idx Index, columnPos []int) {
+	if idx.IndexAttributes == nil {
+		idx.IndexAttributes = map[string]string{}
+	}
+	if columnPos == nil {
+		columnPos = make([]int, len(idx.Columns))
+		for i := range columnPos {
+			columnPos[i] = idx.Pos
+		}
+	}
+	idx.columnPos = columnPos
+	t.Indexes = append(t.Indexes, idx)
+}
@@ -0,0 +1,36 @@
+package erd
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestBuild(t *testing.T) {
+	e := &Erd{}
+	e.NewGroup("Core", nil, 0)
+	person := e.NewTable("Person", map[string]string{"group": "Core"}, 0)
+	person.AppendColumn(Column{Title: "id", IsPrimaryKey: true, Pos: 9})
+	person.AppendColumn(Column{Title: "name", Pos: 13})
+	person.AppendIndex(Index{Title: "i", Columns: []string{"name", "nope"}, IsUnique: true, Pos: 18}, []int{21, 27})
+	e.NewTable("Person", nil, 34)
+	e.Relations = append(e.Relations, Relation{LeftTableName: "Person", LeftCardinality: "*", RightTableName: "Person", RightCardinality: "1"})
+
+	e.Validate("test", "[Person]\n*id\nname\ni (name, nope)\n[Person]\n")
+	var got []string
+	for _, d := range e.Diagnostics {
+		got = append(got, d.Error())
+	}
+	want := []string{
+		`test:4:10: error: index "i" references undefined column "nope" of table "Person"`,
+		`test:5:2: error: table "Person" is already declared`,
+	}
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("got %q, want %q", got, want)
+	}
+	if !reflect.DeepEqual(person.PrimaryKeys, []int{0}) || person.Columns[1].ColumnAttributes == nil {
+		t.Errorf("person is %+v", person)
+	}
+	if g := e.Group("Core"); g == nil || len(g.Tables) != 1 || g.GroupAttributes == nil {
+		t.Errorf("group Core is %+v", g)
+	}
+}
@@ -0,0 +1,187 @@
+package erd
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"sort"
+	"strings"
+)
+
+type Severity string
+
+const (
+	SeverityError   Severity = "error"
+	SeverityWarning Severity = "warning"
+)
+
+// Diagnostic is a problem found while parsing or validating a schema. Begin
+// and End are rune offsets into the parsed buffer; the line and column fields
+// are 1-based and filled in by Erd.Validate.
+type Diagnostic struct {
+	Severity    Severity `json:"severity"`
+	Message     string   `json:"message"`
+	File        string   `json:"file"`
+	StartLine   int      `json:"startLine"`
+	StartColumn int      `json:"startColumn"`
+	EndLine     int      `json:"endLine"`
+	EndColumn   int      `json:"endColumn"`
+	Snippet     string   `json:"snippet"`
+	Begin       int      `json:"-"`
+	End         int      `json:"-"`
+}
+
+func (d *Diagnostic) Error() string {
+	return fmt.Sprintf("%s:%d:%d: %s: %s", d.File, d.StartLine, d.StartColumn, d.Severity, d.Message)
+}
+
+// String formats the diagnostic the way compilers do: the position and
+// message, followed by the offending source line with the range marked.
+func (d *Diagnostic) String() string {
+	s := d.Error()
+	if d.Snippet == "" {
+		return s
+	}
+	width := 1
+	if d.EndLine == d.StartLine && d.EndColumn > d.StartColumn {
+		width = d.EndColumn - d.StartColumn
+	}
+	indent := strings.Map(func(r rune) rune {
+		if r == '\t' {
+			return r
+		}
+		return ' '
+	}, string([]rune(d.Snippet)[:d.StartColumn-1]))
+	return s + "\n" + d.Snippet + "\n" + indent + "^" + strings.Repeat("~", width-1)
+}
+
+func (e *Erd) report(severity Severity, begin, end int, format string, a ...interface{}) {
+	e.Diagnostics = append(e.Diagnostics, &Diagnostic{
+		Severity: severity,
+		Message:  fmt.Sprintf(format, a...),
+		Begin:    begin,
+		End:      end,
+	})
+	if severity == SeverityError {
+		e.IsError = true
+	}
+}
+
+func (e *Erd) errorf(begin, end int, format string, a ...interface{}) {
+	e.report(SeverityError, begin, end, format, a...)
+}
+
+// Errorf reports an error between the rune offsets begin and end of the
+// source. It lets packages that build a model from other formats report
+// their problems like the parser does; Validate locates them.
+func (e *Erd) Errorf(begin, end int, format string, a ...interface{}) {
+	e.errorf(begin, end, format, a...)
+}
+
+// Warnf is like Errorf for a problem that leaves the model valid.
+func (e *Erd) Warnf(begin, end int, format string, a ...interface{}) {
+	e.report(SeverityWarning, begin, end, format, a...)
+}
+
+// Errors returns the error diagnostics of e as an ErrorList, or nil if
+// there are none.
+func (e *Erd) Errors() error {
+	if !e.IsError {
+		return nil
+	}
+	var errs ErrorList
+	for _, d := range e.Diagnostics {
+		if d.Severity == SeverityError {
+			errs = append(errs, d)
+		}
+	}
+	return errs
+}
+
+// locateDiagnostics sorts the diagnostics into source order and resolves
+// their offsets into lines, columns and snippets of buffer. When buffer was
+// built by Load the offsets are resolved in the files it was built from.
+func (e *Erd) locateDiagnostics(file string, buffer string) {
+	runes := []rune(buffer)
+	lines := lineColumns(runes)
+	sort.SliceStable(e.Diagnostics, func(i, j int) bool {
+		return e.Diagnostics[i].Begin < e.Diagnostics[j].Begin
+	})
+	for _, d := range e.Diagnostics {
+		if len(e.sources) > 0 {
+			e.locateInSource(d)
+			continue
+		}
+		d.File = file
+		d.StartLine, d.StartColumn = lines(d.Begin)
+		d.EndLine, d.EndColumn = lines(d.End)
+		d.Snippet = sourceLine(runes, d.Begin)
+	}
+}
+
+// locateInSource is locateDiagnostics for a diagnostic of a buffer built by
+// Load. A range running into another file is cut at the end of the line.
+func (e *Erd) locateInSource(d *Diagnostic) {
+	file, text, begin := e.locate(d.Begin)
+	endFile, _, end := e.locate(d.End)
+	if endFile != file || end < begin {
+		end = begin
+		for end < len(text) && text[end] != '\n' && text[end] != '\r' {
+			end++
+		}
+	}
+	lines := lineColumns(text)
+	d.File = file
+	d.StartLine, d.StartColumn = lines(begin)
+	d.EndLine, d.EndColumn = lines(end)
+	d.Snippet = sourceLine(text, begin)
+}
+
+func sourceLine(buffer []rune, pos int) string {
+	if pos > len(buffer) {
+		pos = len(buffer)
+	}
+	begin, end := pos, pos
+	for begin > 0 && buffer[begin-1] != '\n' {
+		begin--
+	}
+	for end < len(buffer) && buffer[end] != '\n' {
+		end++
+	}
+	return strings.TrimRight(string(buffer[begin:end]), "\r")
+}
+
+// lineColumns returns a function translating rune offsets in buffer into
+// 1-based line and column numbers.
+func lineColumns(buffer []rune) func(pos int) (int, int) {
+	var starts []int
+	starts = append(starts, 0)
+	for i, r := range buffer {
+		if r == '\n' {
+			starts = append(starts, i+1)
+		}
+	}
+	return func(pos int) (int, int) {
+		line := sort.Search(len(starts), func(i int) bool { return starts[i] > pos })
+		return line, pos - starts[line-1] + 1
+	}
+}
+
+func WriteDiagnostics(w io.Writer, diagnostics []*Diagnostic) error {
+	for _, d := range diagnostics {
+		if _, err := fmt.Fprintln(w, d.String()); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func WriteDiagnosticsJSON(w io.Writer, diagnostics []*Diagnostic) error {
+	if diagnostics == nil {
+		diagnostics = []*Diagnostic{}
+	}
+	enc := json.NewEncoder(w)
+	enc.SetIndent("", "  ")
+	enc.SetEscapeHTML(false)
+	return enc.Encode(diagnostics)
+}
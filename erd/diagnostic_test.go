@@ -0,0 +1,96 @@
+package erd
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestDiagnostic_String(t *testing.T) {
+	buffer := "[Person]\n*name\n\n\tPerson *--1 Locaton {label: \"born in\"}\n"
+	parser := &Parser{Buffer: buffer}
+	parser.Init()
+	if err := parser.Parse(); err != nil {
+		t.Fatal(err)
+	}
+	parser.Execute()
+	parser.Erd.Validate("test.er", buffer)
+
+	if len(parser.Erd.Diagnostics) != 1 {
+		t.Fatalf("got: %v diagnostics\nwant: 1", len(parser.Erd.Diagnostics))
+	}
+	d := parser.Erd.Diagnostics[0]
+	if d.StartLine != 4 || d.StartColumn != 14 || d.EndLine != 4 || d.EndColumn != 21 {
+		t.Errorf("got: %v:%v-%v:%v\nwant: 4:14-4:21", d.StartLine, d.StartColumn, d.EndLine, d.EndColumn)
+	}
+	want := "test.er:4:14: error: relation references undefined table \"Locaton\"\n" +
+		"\tPerson *--1 Locaton {label: \"born in\"}\n" +
+		"\t            ^~~~~~~"
+	if got := d.String(); got != want {
+		t.Errorf("got:\n%v\nwant:\n%v", got, want)
+	}
+}
+
+func TestErd_Err(t *testing.T) {
+	buffer := "[Person]\n*name\n\nPerson ~~ Location\n"
+	parser := &Parser{Buffer: buffer}
+	parser.Init()
+	if err := parser.Parse(); err != nil {
+		t.Fatal(err)
+	}
+	parser.Execute()
+	parser.Erd.Validate("test.er", buffer)
+
+	if !parser.Erd.IsError || len(parser.Erd.Diagnostics) != 1 {
+		t.Fatalf("got: %v\nwant: a single syntax error", parser.Erd.Diagnostics)
+	}
+	if got, want := parser.Erd.Diagnostics[0].Error(), "test.er:4:1: error: syntax error"; got != want {
+		t.Errorf("got: %v\nwant: %v", got, want)
+	}
+}
+
+func TestParser_recovery(t *testing.T) {
+	buffer := `[Person]
+*name
+height: 180
+weight
+# comment inside a table
+
+[Location
+*id
+city
+
+Person *-1 Location
+Person *--1 Location
+!!!
+`
+	parser := &Parser{Buffer: buffer}
+	parser.Init()
+	if err := parser.Parse(); err != nil {
+		t.Fatal(err)
+	}
+	parser.Execute()
+	parser.Erd.Validate("test.er", buffer)
+
+	var got []string
+	for _, d := range parser.Erd.Diagnostics {
+		got = append(got, d.Error())
+	}
+	want := []string{
+		"test.er:3:1: error: syntax error",
+		"test.er:7:1: error: syntax error",
+		"test.er:11:1: error: syntax error",
+		"test.er:12:13: error: relation references undefined table \"Location\"",
+		"test.er:13:1: error: syntax error",
+	}
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("got: %q\nwant: %q", got, want)
+	}
+
+	person := parser.Erd.Table("Person")
+	if len(person.Columns) != 2 || person.Columns[1].Title != "weight" {
+		t.Errorf("got: %+v\nwant: columns name and weight", person.Columns)
+	}
+	if len(parser.Erd.Relations) != 1 {
+		t.Errorf("got: %v relations\nwant: 1", len(parser.Erd.Relations))
+	}
+}
@@ -0,0 +1,88 @@
+// Package erd parses plain text descriptions of relational database schemas
+// into a model that can be rendered as an entity-relationship diagram.
+package erd
+
+import (
+	"fmt"
+	"io"
+	"io/ioutil"
+)
+
+// ErrorList is returned by Parse when the schema has errors. It holds every
+// error diagnostic that was reported, in source order.
+type ErrorList []*Diagnostic
+
+func (l ErrorList) Error() string {
+	switch len(l) {
+	case 0:
+		return "no errors"
+	case 1:
+		return l[0].Error()
+	}
+	return fmt.Sprintf("%s (and %d more errors)", l[0], len(l)-1)
+}
+
+// Parse reads a schema from r and returns its model. If the schema has
+// syntax errors or unresolved references the returned error is an ErrorList
+// and the model holds as much of the schema as could be parsed.
+func Parse(r io.Reader) (*Erd, error) {
+	buffer, err := ioutil.ReadAll(r)
+	if err != nil {
+		return nil, err
+	}
+	return ParseString("<input>", string(buffer))
+}
+
+// ParseString is like Parse but takes the schema as a string. name labels
+// the diagnostics, typically it is the file the schema was read from.
+// Include lines are recorded in Includes but the files are not read, and
+// relations to undeclared tables are then assumed to reference the tables
+// of those files; see Load.
+func ParseString(name string, buffer string) (*Erd, error) {
+	return parse(name, buffer, nil, nil)
+}
+
+// parse parses buffer and validates the model. sources maps the buffer built
+// by Load back to the files it was read from, and is nil for a single file.
+// prepare, if not nil, is called on the model before it is validated.
+func parse(name string, buffer string, sources []source, prepare func(e *Erd)) (e *Erd, err error) {
+	parser := &Parser{Buffer: buffer}
+	defer func() {
+		if r := recover(); r != nil {
+			e, err = nil, fmt.Errorf("%s: internal parser error: %v", name, r)
+		}
+	}()
+
+	parser.Init()
+	if err := parser.Parse(); err != nil {
+		return nil, err
+	}
+	parser.Erd.sources = sources
+	parser.Execute()
+	if prepare != nil {
+		prepare(&parser.Erd)
+	}
+	parser.Erd.markBlankLines(buffer)
+	parser.Erd.Validate(name, buffer)
+
+	e = &parser.Erd
+	return e, e.Errors()
+}
+
+// markBlankLines sets BlankAfter on the comments that are followed by a
+// blank line in buffer.
+func (e *Erd) markBlankLines(buffer string) {
+	runes := []rune(buffer)
+	for i := range e.Comments {
+		c := &e.Comments[i]
+		pos := c.Pos + len([]rune(c.Text))
+		for pos < len(runes) && runes[pos] != '\n' {
+			pos++
+		}
+		pos++
+		for pos < len(runes) && (runes[pos] == ' ' || runes[pos] == '\t' || runes[pos] == '\r') {
+			pos++
+		}
+		c.BlankAfter = pos < len(runes) && runes[pos] == '\n'
+	}
+}
@@ -0,0 +1,4874 @@
+package erd
+
+//go:generate peg erd.peg
+
+import (
+	"fmt"
+	"io"
+	"math"
+	"os"
+	"sort"
+	"strconv"
+)
+
+const endSymbol rune = 1114112
+
+/* The rule types inferred from the grammar are below. */
+type pegRule uint8
+
+const (
+	ruleUnknown pegRule = iota
+	ruleroot
+	ruleEOT
+	ruleexpression
+	ruleerror_block
+	ruleerror_line
+	ruleempty_line
+	rulecomment_line
+	ruletitle_info
+	rulegroup_info
+	ruleinclude_info
+	ruletable_info
+	ruletable_error
+	ruletable_title
+	ruletable_column
+	rulecolumn_name
+	rulecolumn_key
+	rulecolumn_definition
+	rulecolumn_type
+	rulecolumn_constraint
+	rulecolumn_keyword
+	rulecolumn_default
+	ruletable_index
+	ruleindex_name
+	ruleindex_column
+	rulerelation_info
+	rulerelation_left
+	rulecardinality_left
+	rulerelation_right
+	rulecardinality_right
+	ruletitle_attribute
+	rulegroup_attribute
+	ruletable_attribute
+	rulecolumn_attribute
+	rulerelation_attribute
+	ruleindex_attribute
+	ruleattribute_key
+	ruleattribute_value
+	rulebare_value
+	rulequoted_value
+	ruleattribute_sep
+	rulecomment_string
+	rulews
+	rulenewline
+	rulenewline_or_eot
+	rulespace
+	rulestring
+	rulestring_in_quote
+	rulerelation_name
+	rulequoted_name
+	ruleindex_string
+	ruletype_string
+	rulecardinality
+	rulePegText
+	ruleAction0
+	ruleAction1
+	ruleAction2
+	ruleAction3
+	ruleAction4
+	ruleAction5
+	ruleAction6
+	ruleAction7
+	ruleAction8
+	ruleAction9
+	ruleAction10
+	ruleAction11
+	ruleAction12
+	ruleAction13
+	ruleAction14
+	ruleAction15
+	ruleAction16
+	ruleAction17
+	ruleAction18
+	ruleAction19
+	ruleAction20
+	ruleAction21
+	ruleAction22
+	ruleAction23
+	ruleAction24
+	ruleAction25
+	ruleAction26
+	ruleAction27
+	ruleAction28
+	ruleAction29
+	ruleAction30
+	ruleAction31
+	ruleAction32
+	ruleAction33
+	ruleAction34
+	ruleAction35
+	ruleAction36
+)
+
+var rul3s = [...]string{
+	"Unknown",
+	"root",
+	"EOT",
+	"expression",
+	"error_block",
+	"error_line",
+	"empty_line",
+	"comment_line",
+	"title_info",
+	"group_info",
+	"include_info",
+	"table_info",
+	"table_error",
+	"table_title",
+	"table_column",
+	"column_name",
+	"column_key",
+	"column_definition",
+	"column_type",
+	"column_constraint",
+	"column_keyword",
+	"column_default",
+	"table_index",
+	"index_name",
+	"index_column",
+	"relation_info",
+	"relation_left",
+	"cardinality_left",
+	"relation_right",
+	"cardinality_right",
+	"title_attribute",
+	"group_attribute",
+	"table_attribute",
+	"column_attribute",
+	"relation_attribute",
+	"index_attribute",
+	"attribute_key",
+	"attribute_value",
+	"bare_value",
+	"quoted_value",
+	"attribute_sep",
+	"comment_string",
+	"ws",
+	"newline",
+	"newline_or_eot",
+	"space",
+	"string",
+	"string_in_quote",
+	"relation_name",
+	"quoted_name",
+	"index_string",
+	"type_string",
+	"cardinality",
+	"PegText",
+	"Action0",
+	"Action1",
+	"Action2",
+	"Action3",
+	"Action4",
+	"Action5",
+	"Action6",
+	"Action7",
+	"Action8",
+	"Action9",
+	"Action10",
+	"Action11",
+	"Action12",
+	"Action13",
+	"Action14",
+	"Action15",
+	"Action16",
+	"Action17",
+	"Action18",
+	"Action19",
+	"Action20",
+	"Action21",
+	"Action22",
+	"Action23",
+	"Action24",
+	"Action25",
+	"Action26",
+	"Action27",
+	"Action28",
+	"Action29",
+	"Action30",
+	"Action31",
+	"Action32",
+	"Action33",
+	"Action34",
+	"Action35",
+	"Action36",
+}
+
+type token32 struct {
+	pegRule
+	begin, end uint32
+}
+
+func (t *token32) String() string {
+	return fmt.Sprintf("\x1B[34m%v\x1B[m %v %v", rul3s[t.pegRule], t.begin, t.end)
+}
+
+type node32 struct {
+	token32
+	up, next *node32
+}
+
+func (node *node32) print(w io.Writer, pretty bool, buffer string) {
+	var print func(node *node32, depth int)
+	print = func(node *node32, depth int) {
+		for node != nil {
+			for c := 0; c < depth; c++ {
+				fmt.Fprintf(w, " ")
+			}
+			rule := rul3s[node.pegRule]
+			quote := strconv.Quote(string(([]rune(buffer)[node.begin:node.end])))
+			if !pretty {
+				fmt.Fprintf(w, "%v %v\n", rule, quote)
+			} else {
+				fmt.Fprintf(w, "\x1B[34m%v\x1B[m %v\n", rule, quote)
+			}
+			if node.up != nil {
+				print(node.up, depth+1)
+			}
+			node = node.next
+		}
+	}
+	print(node, 0)
+}
+
+func (node *node32) Print(w io.Writer, buffer string) {
+	node.print(w, false, buffer)
+}
+
+func (node *node32) PrettyPrint(w io.Writer, buffer string) {
+	node.print(w, true, buffer)
+}
+
+type tokens32 struct {
+	tree []token32
+}
+
+func (t *tokens32) Trim(length uint32) {
+	t.tree = t.tree[:length]
+}
+
+func (t *tokens32) Print() {
+	for _, token := range t.tree {
+		fmt.Println(token.String())
+	}
+}
+
+func (t *tokens32) AST() *node32 {
+	type element struct {
+		node *node32
+		down *element
+	}
+	tokens := t.Tokens()
+	var stack *element
+	for _, token := range tokens {
+		if token.begin == token.end {
+			continue
+		}
+		node := &node32{token32: token}
+		for stack != nil && stack.node.begin >= token.begin && stack.node.end <= token.end {
+			stack.node.next = node.up
+			node.up = stack.node
+			stack = stack.down
+		}
+		stack = &element{node: node, down: stack}
+	}
+	if stack != nil {
+		return stack.node
+	}
+	return nil
+}
+
+func (t *tokens32) PrintSyntaxTree(buffer string) {
+	t.AST().Print(os.Stdout, buffer)
+}
+
+func (t *tokens32) WriteSyntaxTree(w io.Writer, buffer string) {
+	t.AST().Print(w, buffer)
+}
+
+func (t *tokens32) PrettyPrintSyntaxTree(buffer string) {
+	t.AST().PrettyPrint(os.Stdout, buffer)
+}
+
+func (t *tokens32) Add(rule pegRule, begin, end, index uint32) {
+	if tree := t.tree; int(index) >= len(tree) {
+		expanded := make([]token32, 2*len(tree))
+		copy(expanded, tree)
+		t.tree = expanded
+	}
+	t.tree[index] = token32{
+		pegRule: rule,
+		begin:   begin,
+		end:     end,
+	}
+}
+
+func (t *tokens32) Tokens() []token32 {
+	return t.tree
+}
+
+type Parser struct {
+	Erd
+
+	Buffer string
+	buffer []rune
+	rules  [91]func() bool
+	parse  func(rule ...int) error
+	reset  func()
+	Pretty bool
+	tokens32
+}
+
+func (p *Parser) Parse(rule ...int) error {
+	return p.parse(rule...)
+}
+
+func (p *Parser) Reset() {
+	p.reset()
+}
+
+type textPosition struct {
+	line, symbol int
+}
+
+type textPositionMap map[int]textPosition
+
+func translatePositions(buffer []rune, positions []int) textPositionMap {
+	length, translations, j, line, symbol := len(positions), make(textPositionMap, len(positions)), 0, 1, 0
+	sort.Ints(positions)
+
+search:
+	for i, c := range buffer {
+		if c == '\n' {
+			line, symbol = line+1, 0
+		} else {
+			symbol++
+		}
+		if i == positions[j] {
+			translations[positions[j]] = textPosition{line, symbol}
+			for j++; j < length; j++ {
+				if i != positions[j] {
+					continue search
+				}
+			}
+			break search
+		}
+	}
+
+	return translations
+}
+
+type parseError struct {
+	p   *Parser
+	max token32
+}
+
+func (e *parseError) Error() string {
+	tokens, error := []token32{e.max}, "\n"
+	positions, p := make([]int, 2*len(tokens)), 0
+	for _, token := range tokens {
+		positions[p], p = int(token.begin), p+1
+		positions[p], p = int(token.end), p+1
+	}
+	translations := translatePositions(e.p.buffer, positions)
+	format := "parse error near %v (line %v symbol %v - line %v symbol %v):\n%v\n"
+	if e.p.Pretty {
+		format = "parse error near \x1B[34m%v\x1B[m (line %v symbol %v - line %v symbol %v):\n%v\n"
+	}
+	for _, token := range tokens {
+		begin, end := int(token.begin), int(token.end)
+		error += fmt.Sprintf(format,
+			rul3s[token.pegRule],
+			translations[begin].line, translations[begin].symbol,
+			translations[end].line, translations[end].symbol,
+			strconv.Quote(string(e.p.buffer[begin:end])))
+	}
+
+	return error
+}
+
+func (p *Parser) PrintSyntaxTree() {
+	if p.Pretty {
+		p.tokens32.PrettyPrintSyntaxTree(p.Buffer)
+	} else {
+		p.tokens32.PrintSyntaxTree(p.Buffer)
+	}
+}
+
+func (p *Parser) WriteSyntaxTree(w io.Writer) {
+	p.tokens32.WriteSyntaxTree(w, p.Buffer)
+}
+
+func (p *Parser) Execute() {
+	buffer, _buffer, text, begin, end := p.Buffer, p.buffer, "", 0, 0
+	for _, token := range p.Tokens() {
+		switch token.pegRule {
+
+		case rulePegText:
+			begin, end = int(token.begin), int(token.end)
+			text = string(_buffer[begin:end])
+
+		case ruleAction0:
+			p.SkipTable(begin)
+		case ruleAction1:
+			p.Err(begin, buffer)
+		case ruleAction2:
+			p.ClearTableAndColumn()
+		case ruleAction3:
+			p.AddComment(text, begin)
+		case ruleAction4:
+			p.SetTitlePos(begin)
+		case ruleAction5:
+			p.AddGroup(text, begin)
+		case ruleAction6:
+			p.AddInclude(text, begin)
+		case ruleAction7:
+			p.AddTable(text, begin)
+		case ruleAction8:
+			p.AddColumn(text, begin)
+		case ruleAction9:
+			p.SetPrimaryKey()
+		case ruleAction10:
+			p.SetForeignKey()
+		case ruleAction11:
+			p.SetColumnType(text)
+		case ruleAction12:
+			p.SetColumnNotNull(true)
+		case ruleAction13:
+			p.SetColumnNotNull(false)
+		case ruleAction14:
+			p.SetColumnDefault(text)
+		case ruleAction15:
+			p.AddIndex(text, begin)
+		case ruleAction16:
+			p.AddIndexColumn(text, begin)
+		case ruleAction17:
+			p.AddRelation()
+		case ruleAction18:
+			p.SetRelationLeft(p.unquote(text, begin), begin)
+		case ruleAction19:
+			p.SetRelationLeft(text, begin)
+		case ruleAction20:
+			p.SetRelationLeftColumn(p.unquote(text, begin))
+		case ruleAction21:
+			p.SetRelationLeftColumn(text)
+		case ruleAction22:
+			p.SetCardinalityLeft(text)
+		case ruleAction23:
+			p.SetRelationRight(p.unquote(text, begin), begin)
+		case ruleAction24:
+			p.SetRelationRight(text, begin)
+		case ruleAction25:
+			p.SetRelationRightColumn(p.unquote(text, begin))
+		case ruleAction26:
+			p.SetRelationRightColumn(text)
+		case ruleAction27:
+			p.SetCardinalityRight(text)
+		case ruleAction28:
+			p.AddTitleKeyValue()
+		case ruleAction29:
+			p.AddGroupKeyValue()
+		case ruleAction30:
+			p.AddTableKeyValue()
+		case ruleAction31:
+			p.AddColumnKeyValue()
+		case ruleAction32:
+			p.AddRelationKeyValue()
+		case ruleAction33:
+			p.AddIndexKeyValue()
+		case ruleAction34:
+			p.SetKey(text, begin)
+		case ruleAction35:
+			p.SetValue(text, begin)
+		case ruleAction36:
+			p.SetValue(text, begin)
+
+		}
+	}
+	_, _, _, _, _ = buffer, _buffer, text, begin, end
+}
+
+func (p *Parser) Init() {
+	var (
+		max                  token32
+		position, tokenIndex uint32
+		buffer               []rune
+	)
+	p.reset = func() {
+		max = token32{}
+		position, tokenIndex = 0, 0
+
+		p.buffer = []rune(p.Buffer)
+		if len(p.buffer) == 0 || p.buffer[len(p.buffer)-1] != endSymbol {
+			p.buffer = append(p.buffer, endSymbol)
+		}
+		buffer = p.buffer
+	}
+	p.reset()
+
+	_rules := p.rules
+	tree := tokens32{tree: make([]token32, math.MaxInt16)}
+	p.parse = func(rule ...int) error {
+		r := 1
+		if len(rule) > 0 {
+			r = rule[0]
+		}
+		matches := p.rules[r]()
+		p.tokens32 = tree
+		if matches {
+			p.Trim(tokenIndex)
+			return nil
+		}
+		return &parseError{p, max}
+	}
+
+	add := func(rule pegRule, begin uint32) {
+		tree.Add(rule, begin, position, tokenIndex)
+		tokenIndex++
+		if begin != position && position > max.end {
+			max = token32{rule, begin, position}
+		}
+	}
+
+	matchDot := func() bool {
+		if buffer[position] != endSymbol {
+			position++
+			return true
+		}
+		return false
+	}
+
+	/*matchChar := func(c byte) bool {
+		if buffer[position] == c {
+			position++
+			return true
+		}
+		return false
+	}*/
+
+	_rules = [...]func() bool{
+		nil,
+		/* 0 root <- <((expression / error_block)* EOT)> */
+		func() bool {
+			position0, tokenIndex0 := position, tokenIndex
+			{
+				position1 := position
+			l2:
+				{
+					position3, tokenIndex3 := position, tokenIndex
+					{
+						position4, tokenIndex4 := position, tokenIndex
+						if !_rules[ruleexpression]() {
+							goto l5
+						}
+						goto l4
+					l5:
+						position, tokenIndex = position4, tokenIndex4
+						if !_rules[ruleerror_block]() {
+							goto l3
+						}
+					}
+				l4:
+					goto l2
+				l3:
+					position, tokenIndex = position3, tokenIndex3
+				}
+				if !_rules[ruleEOT]() {
+					goto l0
+				}
+				add(ruleroot, position1)
+			}
+			return true
+		l0:
+			position, tokenIndex = position0, tokenIndex0
+			return false
+		},
+		/* 1 EOT <- <!.> */
+		func() bool {
+			position6, tokenIndex6 := position, tokenIndex
+			{
+				position7 := position
+				{
+					position8, tokenIndex8 := position, tokenIndex
+					if !matchDot() {
+						goto l8
+					}
+					goto l6
+				l8:
+					position, tokenIndex = position8, tokenIndex8
+				}
+				add(ruleEOT, position7)
+			}
+			return true
+		l6:
+			position, tokenIndex = position6, tokenIndex6
+			return false
+		},
+		/* 2 expression <- <(title_info / group_info / include_info / relation_info / table_info / comment_line / empty_line)> */
+		func() bool {
+			position9, tokenIndex9 := position, tokenIndex
+			{
+				position10 := position
+				{
+					position11, tokenIndex11 := position, tokenIndex
+					if !_rules[ruletitle_info]() {
+						goto l12
+					}
+					goto l11
+				l12:
+					position, tokenIndex = position11, tokenIndex11
+					if !_rules[rulegroup_info]() {
+						goto l13
+					}
+					goto l11
+				l13:
+					position, tokenIndex = position11, tokenIndex11
+					if !_rules[ruleinclude_info]() {
+						goto l14
+					}
+					goto l11
+				l14:
+					position, tokenIndex = position11, tokenIndex11
+					if !_rules[rulerelation_info]() {
+						goto l15
+					}
+					goto l11
+				l15:
+					position, tokenIndex = position11, tokenIndex11
+					if !_rules[ruletable_info]() {
+						goto l16
+					}
+					goto l11
+				l16:
+					position, tokenIndex = position11, tokenIndex11
+					if !_rules[rulecomment_line]() {
+						goto l17
+					}
+					goto l11
+				l17:
+					position, tokenIndex = position11, tokenIndex11
+					if !_rules[ruleempty_line]() {
+						goto l9
+					}
+				}
+			l11:
+				add(ruleexpression, position10)
+			}
+			return true
+		l9:
+			position, tokenIndex = position9, tokenIndex9
+			return false
+		},
+		/* 3 error_block <- <(error_line Action0 (comment_line / table_index / table_column)*)> */
+		func() bool {
+			position18, tokenIndex18 := position, tokenIndex
+			{
+				position19 := position
+				if !_rules[ruleerror_line]() {
+					goto l18
+				}
+				if !_rules[ruleAction0]() {
+					goto l18
+				}
+			l20:
+				{
+					position21, tokenIndex21 := position, tokenIndex
+					{
+						position22, tokenIndex22 := position, tokenIndex
+						if !_rules[rulecomment_line]() {
+							goto l23
+						}
+						goto l22
+					l23:
+						position, tokenIndex = position22, tokenIndex22
+						if !_rules[ruletable_index]() {
+							goto l24
+						}
+						goto l22
+					l24:
+						position, tokenIndex = position22, tokenIndex22
+						if !_rules[ruletable_column]() {
+							goto l21
+						}
+					}
+				l22:
+					goto l20
+				l21:
+					position, tokenIndex = position21, tokenIndex21
+				}
+				add(ruleerror_block, position19)
+			}
+			return true
+		l18:
+			position, tokenIndex = position18, tokenIndex18
+			return false
+		},
+		/* 4 error_line <- <(<(!('\r' / '\n') .)+> Action1 newline_or_eot)> */
+		func() bool {
+			position25, tokenIndex25 := position, tokenIndex
+			{
+				position26 := position
+				{
+					position27 := position
+					{
+						position30, tokenIndex30 := position, tokenIndex
+						{
+							position31, tokenIndex31 := position, tokenIndex
+							if buffer[position] != rune('\r') {
+								goto l32
+							}
+							position++
+							goto l31
+						l32:
+							position, tokenIndex = position31, tokenIndex31
+							if buffer[position] != rune('\n') {
+								goto l30
+							}
+							position++
+						}
+					l31:
+						goto l25
+					l30:
+						position, tokenIndex = position30, tokenIndex30
+					}
+					if !matchDot() {
+						goto l25
+					}
+				l28:
+					{
+						position29, tokenIndex29 := position, tokenIndex
+						{
+							position33, tokenIndex33 := position, tokenIndex
+							{
+								position34, tokenIndex34 := position, tokenIndex
+								if buffer[position] != rune('\r') {
+									goto l35
+								}
+								position++
+								goto l34
+							l35:
+								position, tokenIndex = position34, tokenIndex34
+								if buffer[position] != rune('\n') {
+									goto l33
+								}
+								position++
+							}
+						l34:
+							goto l29
+						l33:
+							position, tokenIndex = position33, tokenIndex33
+						}
+						if !matchDot() {
+							goto l29
+						}
+						goto l28
+					l29:
+						position, tokenIndex = position29, tokenIndex29
+					}
+					add(rulePegText, position27)
+				}
+				if !_rules[ruleAction1]() {
+					goto l25
+				}
+				if !_rules[rulenewline_or_eot]() {
+					goto l25
+				}
+				add(ruleerror_line, position26)
+			}
+			return true
+		l25:
+			position, tokenIndex = position25, tokenIndex25
+			return false
+		},
+		/* 5 empty_line <- <(ws Action2)> */
+		func() bool {
+			position36, tokenIndex36 := position, tokenIndex
+			{
+				position37 := position
+				if !_rules[rulews]() {
+					goto l36
+				}
+				if !_rules[ruleAction2]() {
+					goto l36
+				}
+				add(ruleempty_line, position37)
+			}
+			return true
+		l36:
+			position, tokenIndex = position36, tokenIndex36
+			return false
+		},
+		/* 6 comment_line <- <(space* <('#' comment_string)> Action3 newline_or_eot)> */
+		func() bool {
+			position38, tokenIndex38 := position, tokenIndex
+			{
+				position39 := position
+			l40:
+				{
+					position41, tokenIndex41 := position, tokenIndex
+					if !_rules[rulespace]() {
+						goto l41
+					}
+					goto l40
+				l41:
+					position, tokenIndex = position41, tokenIndex41
+				}
+				{
+					position42 := position
+					if buffer[position] != rune('#') {
+						goto l38
+					}
+					position++
+					if !_rules[rulecomment_string]() {
+						goto l38
+					}
+					add(rulePegText, position42)
+				}
+				if !_rules[ruleAction3]() {
+					goto l38
+				}
+				if !_rules[rulenewline_or_eot]() {
+					goto l38
+				}
+				add(rulecomment_line, position39)
+			}
+			return true
+		l38:
+			position, tokenIndex = position38, tokenIndex38
+			return false
+		},
+		/* 7 title_info <- <(<('t' 'i' 't' 'l' 'e')> Action4 ws* '{' ws* (title_attribute ws* attribute_sep? ws*)* ws* '}' newline)> */
+		func() bool {
+			position43, tokenIndex43 := position, tokenIndex
+			{
+				position44 := position
+				{
+					position45 := position
+					if buffer[position] != rune('t') {
+						goto l43
+					}
+					position++
+					if buffer[position] != rune('i') {
+						goto l43
+					}
+					position++
+					if buffer[position] != rune('t') {
+						goto l43
+					}
+					position++
+					if buffer[position] != rune('l') {
+						goto l43
+					}
+					position++
+					if buffer[position] != rune('e') {
+						goto l43
+					}
+					position++
+					add(rulePegText, position45)
+				}
+				if !_rules[ruleAction4]() {
+					goto l43
+				}
+			l46:
+				{
+					position47, tokenIndex47 := position, tokenIndex
+					if !_rules[rulews]() {
+						goto l47
+					}
+					goto l46
+				l47:
+					position, tokenIndex = position47, tokenIndex47
+				}
+				if buffer[position] != rune('{') {
+					goto l43
+				}
+				position++
+			l48:
+				{
+					position49, tokenIndex49 := position, tokenIndex
+					if !_rules[rulews]() {
+						goto l49
+					}
+					goto l48
+				l49:
+					position, tokenIndex = position49, tokenIndex49
+				}
+			l50:
+				{
+					position51, tokenIndex51 := position, tokenIndex
+					if !_rules[ruletitle_attribute]() {
+						goto l51
+					}
+				l52:
+					{
+						position53, tokenIndex53 := position, tokenIndex
+						if !_rules[rulews]() {
+							goto l53
+						}
+						goto l52
+					l53:
+						position, tokenIndex = position53, tokenIndex53
+					}
+					{
+						position54, tokenIndex54 := position, tokenIndex
+						if !_rules[ruleattribute_sep]() {
+							goto l54
+						}
+						goto l55
+					l54:
+						position, tokenIndex = position54, tokenIndex54
+					}
+				l55:
+				l56:
+					{
+						position57, tokenIndex57 := position, tokenIndex
+						if !_rules[rulews]() {
+							goto l57
+						}
+						goto l56
+					l57:
+						position, tokenIndex = position57, tokenIndex57
+					}
+					goto l50
+				l51:
+					position, tokenIndex = position51, tokenIndex51
+				}
+			l58:
+				{
+					position59, tokenIndex59 := position, tokenIndex
+					if !_rules[rulews]() {
+						goto l59
+					}
+					goto l58
+				l59:
+					position, tokenIndex = position59, tokenIndex59
+				}
+				if buffer[position] != rune('}') {
+					goto l43
+				}
+				position++
+				if !_rules[rulenewline]() {
+					goto l43
+				}
+				add(ruletitle_info, position44)
+			}
+			return true
+		l43:
+			position, tokenIndex = position43, tokenIndex43
+			return false
+		},
+		/* 8 group_info <- <(space* ('g' 'r' 'o' 'u' 'p') space+ <('"' string_in_quote '"')> Action5 (space* '{' ws* (group_attribute ws* attribute_sep?)* ws* '}')? space* newline_or_eot)> */
+		func() bool {
+			position60, tokenIndex60 := position, tokenIndex
+			{
+				position61 := position
+			l62:
+				{
+					position63, tokenIndex63 := position, tokenIndex
+					if !_rules[rulespace]() {
+						goto l63
+					}
+					goto l62
+				l63:
+					position, tokenIndex = position63, tokenIndex63
+				}
+				if buffer[position] != rune('g') {
+					goto l60
+				}
+				position++
+				if buffer[position] != rune('r') {
+					goto l60
+				}
+				position++
+				if buffer[position] != rune('o') {
+					goto l60
+				}
+				position++
+				if buffer[position] != rune('u') {
+					goto l60
+				}
+				position++
+				if buffer[position] != rune('p') {
+					goto l60
+				}
+				position++
+				if !_rules[rulespace]() {
+					goto l60
+				}
+			l64:
+				{
+					position65, tokenIndex65 := position, tokenIndex
+					if !_rules[rulespace]() {
+						goto l65
+					}
+					goto l64
+				l65:
+					position, tokenIndex = position65, tokenIndex65
+				}
+				{
+					position66 := position
+					if buffer[position] != rune('"') {
+						goto l60
+					}
+					position++
+					if !_rules[rulestring_in_quote]() {
+						goto l60
+					}
+					if buffer[position] != rune('"') {
+						goto l60
+					}
+					position++
+					add(rulePegText, position66)
+				}
+				if !_rules[ruleAction5]() {
+					goto l60
+				}
+				{
+					position67, tokenIndex67 := position, tokenIndex
+				l69:
+					{
+						position70, tokenIndex70 := position, tokenIndex
+						if !_rules[rulespace]() {
+							goto l70
+						}
+						goto l69
+					l70:
+						position, tokenIndex = position70, tokenIndex70
+					}
+					if buffer[position] != rune('{') {
+						goto l67
+					}
+					position++
+				l71:
+					{
+						position72, tokenIndex72 := position, tokenIndex
+						if !_rules[rulews]() {
+							goto l72
+						}
+						goto l71
+					l72:
+						position, tokenIndex = position72, tokenIndex72
+					}
+				l73:
+					{
+						position74, tokenIndex74 := position, tokenIndex
+						if !_rules[rulegroup_attribute]() {
+							goto l74
+						}
+					l75:
+						{
+							position76, tokenIndex76 := position, tokenIndex
+							if !_rules[rulews]() {
+								goto l76
+							}
+							goto l75
+						l76:
+							position, tokenIndex = position76, tokenIndex76
+						}
+						{
+							position77, tokenIndex77 := position, tokenIndex
+							if !_rules[ruleattribute_sep]() {
+								goto l77
+							}
+							goto l78
+						l77:
+							position, tokenIndex = position77, tokenIndex77
+						}
+					l78:
+						goto l73
+					l74:
+						position, tokenIndex = position74, tokenIndex74
+					}
+				l79:
+					{
+						position80, tokenIndex80 := position, tokenIndex
+						if !_rules[rulews]() {
+							goto l80
+						}
+						goto l79
+					l80:
+						position, tokenIndex = position80, tokenIndex80
+					}
+					if buffer[position] != rune('}') {
+						goto l67
+					}
+					position++
+					goto l68
+				l67:
+					position, tokenIndex = position67, tokenIndex67
+				}
+			l68:
+			l81:
+				{
+					position82, tokenIndex82 := position, tokenIndex
+					if !_rules[rulespace]() {
+						goto l82
+					}
+					goto l81
+				l82:
+					position, tokenIndex = position82, tokenIndex82
+				}
+				if !_rules[rulenewline_or_eot]() {
+					goto l60
+				}
+				add(rulegroup_info, position61)
+			}
+			return true
+		l60:
+			position, tokenIndex = position60, tokenIndex60
+			return false
+		},
+		/* 9 include_info <- <(space* ('i' 'n' 'c' 'l' 'u' 'd' 'e') space+ <('"' string_in_quote '"')> Action6 space* newline_or_eot)> */
+		func() bool {
+			position83, tokenIndex83 := position, tokenIndex
+			{
+				position84 := position
+			l85:
+				{
+					position86, tokenIndex86 := position, tokenIndex
+					if !_rules[rulespace]() {
+						goto l86
+					}
+					goto l85
+				l86:
+					position, tokenIndex = position86, tokenIndex86
+				}
+				if buffer[position] != rune('i') {
+					goto l83
+				}
+				position++
+				if buffer[position] != rune('n') {
+					goto l83
+				}
+				position++
+				if buffer[position] != rune('c') {
+					goto l83
+				}
+				position++
+				if buffer[position] != rune('l') {
+					goto l83
+				}
+				position++
+				if buffer[position] != rune('u') {
+					goto l83
+				}
+				position++
+				if buffer[position] != rune('d') {
+					goto l83
+				}
+				position++
+				if buffer[position] != rune('e') {
+					goto l83
+				}
+				position++
+				if !_rules[rulespace]() {
+					goto l83
+				}
+			l87:
+				{
+					position88, tokenIndex88 := position, tokenIndex
+					if !_rules[rulespace]() {
+						goto l88
+					}
+					goto l87
+				l88:
+					position, tokenIndex = position88, tokenIndex88
+				}
+				{
+					position89 := position
+					if buffer[position] != rune('"') {
+						goto l83
+					}
+					position++
+					if !_rules[rulestring_in_quote]() {
+						goto l83
+					}
+					if buffer[position] != rune('"') {
+						goto l83
+					}
+					position++
+					add(rulePegText, position89)
+				}
+				if !_rules[ruleAction6]() {
+					goto l83
+				}
+			l90:
+				{
+					position91, tokenIndex91 := position, tokenIndex
+					if !_rules[rulespace]() {
+						goto l91
+					}
+					goto l90
+				l91:
+					position, tokenIndex = position91, tokenIndex91
+				}
+				if !_rules[rulenewline_or_eot]() {
+					goto l83
+				}
+				add(ruleinclude_info, position84)
+			}
+			return true
+		l83:
+			position, tokenIndex = position83, tokenIndex83
+			return false
+		},
+		/* 10 table_info <- <('[' table_title ']' (space* '{' ws* (table_attribute ws* attribute_sep?)* ws* '}' space*)? newline_or_eot (comment_line / table_index / (!group_info !include_info table_column) / ws / table_error)*)> */
+		func() bool {
+			position92, tokenIndex92 := position, tokenIndex
+			{
+				position93 := position
+				if buffer[position] != rune('[') {
+					goto l92
+				}
+				position++
+				if !_rules[ruletable_title]() {
+					goto l92
+				}
+				if buffer[position] != rune(']') {
+					goto l92
+				}
+				position++
+				{
+					position94, tokenIndex94 := position, tokenIndex
+				l96:
+					{
+						position97, tokenIndex97 := position, tokenIndex
+						if !_rules[rulespace]() {
+							goto l97
+						}
+						goto l96
+					l97:
+						position, tokenIndex = position97, tokenIndex97
+					}
+					if buffer[position] != rune('{') {
+						goto l94
+					}
+					position++
+				l98:
+					{
+						position99, tokenIndex99 := position, tokenIndex
+						if !_rules[rulews]() {
+							goto l99
+						}
+						goto l98
+					l99:
+						position, tokenIndex = position99, tokenIndex99
+					}
+				l100:
+					{
+						position101, tokenIndex101 := position, tokenIndex
+						if !_rules[ruletable_attribute]() {
+							goto l101
+						}
+					l102:
+						{
+							position103, tokenIndex103 := position, tokenIndex
+							if !_rules[rulews]() {
+								goto l103
+							}
+							goto l102
+						l103:
+							position, tokenIndex = position103, tokenIndex103
+						}
+						{
+							position104, tokenIndex104 := position, tokenIndex
+							if !_rules[ruleattribute_sep]() {
+								goto l104
+							}
+							goto l105
+						l104:
+							position, tokenIndex = position104, tokenIndex104
+						}
+					l105:
+						goto l100
+					l101:
+						position, tokenIndex = position101, tokenIndex101
+					}
+				l106:
+					{
+						position107, tokenIndex107 := position, tokenIndex
+						if !_rules[rulews]() {
+							goto l107
+						}
+						goto l106
+					l107:
+						position, tokenIndex = position107, tokenIndex107
+					}
+					if buffer[position] != rune('}') {
+						goto l94
+					}
+					position++
+				l108:
+					{
+						position109, tokenIndex109 := position, tokenIndex
+						if !_rules[rulespace]() {
+							goto l109
+						}
+						goto l108
+					l109:
+						position, tokenIndex = position109, tokenIndex109
+					}
+					goto l95
+				l94:
+					position, tokenIndex = position94, tokenIndex94
+				}
+			l95:
+				if !_rules[rulenewline_or_eot]() {
+					goto l92
+				}
+			l110:
+				{
+					position111, tokenIndex111 := position, tokenIndex
+					{
+						position112, tokenIndex112 := position, tokenIndex
+						if !_rules[rulecomment_line]() {
+							goto l113
+						}
+						goto l112
+					l113:
+						position, tokenIndex = position112, tokenIndex112
+						if !_rules[ruletable_index]() {
+							goto l114
+						}
+						goto l112
+					l114:
+						position, tokenIndex = position112, tokenIndex112
+						{
+							position116, tokenIndex116 := position, tokenIndex
+							if !_rules[rulegroup_info]() {
+								goto l116
+							}
+							goto l115
+						l116:
+							position, tokenIndex = position116, tokenIndex116
+						}
+						{
+							position117, tokenIndex117 := position, tokenIndex
+							if !_rules[ruleinclude_info]() {
+								goto l117
+							}
+							goto l115
+						l117:
+							position, tokenIndex = position117, tokenIndex117
+						}
+						if !_rules[ruletable_column]() {
+							goto l115
+						}
+						goto l112
+					l115:
+						position, tokenIndex = position112, tokenIndex112
+						if !_rules[rulews]() {
+							goto l118
+						}
+						goto l112
+					l118:
+						position, tokenIndex = position112, tokenIndex112
+						if !_rules[ruletable_error]() {
+							goto l111
+						}
+					}
+				l112:
+					goto l110
+				l111:
+					position, tokenIndex = position111, tokenIndex111
+				}
+				add(ruletable_info, position93)
+			}
+			return true
+		l92:
+			position, tokenIndex = position92, tokenIndex92
+			return false
+		},
+		/* 11 table_error <- <(!'[' !title_info !group_info !include_info !relation_info error_line)> */
+		func() bool {
+			position119, tokenIndex119 := position, tokenIndex
+			{
+				position120 := position
+				{
+					position121, tokenIndex121 := position, tokenIndex
+					if buffer[position] != rune('[') {
+						goto l121
+					}
+					position++
+					goto l119
+				l121:
+					position, tokenIndex = position121, tokenIndex121
+				}
+				{
+					position122, tokenIndex122 := position, tokenIndex
+					if !_rules[ruletitle_info]() {
+						goto l122
+					}
+					goto l119
+				l122:
+					position, tokenIndex = position122, tokenIndex122
+				}
+				{
+					position123, tokenIndex123 := position, tokenIndex
+					if !_rules[rulegroup_info]() {
+						goto l123
+					}
+					goto l119
+				l123:
+					position, tokenIndex = position123, tokenIndex123
+				}
+				{
+					position124, tokenIndex124 := position, tokenIndex
+					if !_rules[ruleinclude_info]() {
+						goto l124
+					}
+					goto l119
+				l124:
+					position, tokenIndex = position124, tokenIndex124
+				}
+				{
+					position125, tokenIndex125 := position, tokenIndex
+					if !_rules[rulerelation_info]() {
+						goto l125
+					}
+					goto l119
+				l125:
+					position, tokenIndex = position125, tokenIndex125
+				}
+				if !_rules[ruleerror_line]() {
+					goto l119
+				}
+				add(ruletable_error, position120)
+			}
+			return true
+		l119:
+			position, tokenIndex = position119, tokenIndex119
+			return false
+		},
+		/* 12 table_title <- <(<string> Action7)> */
+		func() bool {
+			position126, tokenIndex126 := position, tokenIndex
+			{
+				position127 := position
+				{
+					position128 := position
+					if !_rules[rulestring]() {
+						goto l126
+					}
+					add(rulePegText, position128)
+				}
+				if !_rules[ruleAction7]() {
+					goto l126
+				}
+				add(ruletable_title, position127)
+			}
+			return true
+		l126:
+			position, tokenIndex = position126, tokenIndex126
+			return false
+		},
+		/* 13 table_column <- <(space* column_name column_definition (space* '{' ws* (column_attribute ws* attribute_sep?)* ws* '}' space*)? newline_or_eot)> */
+		func() bool {
+			position129, tokenIndex129 := position, tokenIndex
+			{
+				position130 := position
+			l131:
+				{
+					position132, tokenIndex132 := position, tokenIndex
+					if !_rules[rulespace]() {
+						goto l132
+					}
+					goto l131
+				l132:
+					position, tokenIndex = position132, tokenIndex132
+				}
+				if !_rules[rulecolumn_name]() {
+					goto l129
+				}
+				if !_rules[rulecolumn_definition]() {
+					goto l129
+				}
+				{
+					position133, tokenIndex133 := position, tokenIndex
+				l135:
+					{
+						position136, tokenIndex136 := position, tokenIndex
+						if !_rules[rulespace]() {
+							goto l136
+						}
+						goto l135
+					l136:
+						position, tokenIndex = position136, tokenIndex136
+					}
+					if buffer[position] != rune('{') {
+						goto l133
+					}
+					position++
+				l137:
+					{
+						position138, tokenIndex138 := position, tokenIndex
+						if !_rules[rulews]() {
+							goto l138
+						}
+						goto l137
+					l138:
+						position, tokenIndex = position138, tokenIndex138
+					}
+				l139:
+					{
+						position140, tokenIndex140 := position, tokenIndex
+						if !_rules[rulecolumn_attribute]() {
+							goto l140
+						}
+					l141:
+						{
+							position142, tokenIndex142 := position, tokenIndex
+							if !_rules[rulews]() {
+								goto l142
+							}
+							goto l141
+						l142:
+							position, tokenIndex = position142, tokenIndex142
+						}
+						{
+							position143, tokenIndex143 := position, tokenIndex
+							if !_rules[ruleattribute_sep]() {
+								goto l143
+							}
+							goto l144
+						l143:
+							position, tokenIndex = position143, tokenIndex143
+						}
+					l144:
+						goto l139
+					l140:
+						position, tokenIndex = position140, tokenIndex140
+					}
+				l145:
+					{
+						position146, tokenIndex146 := position, tokenIndex
+						if !_rules[rulews]() {
+							goto l146
+						}
+						goto l145
+					l146:
+						position, tokenIndex = position146, tokenIndex146
+					}
+					if buffer[position] != rune('}') {
+						goto l133
+					}
+					position++
+				l147:
+					{
+						position148, tokenIndex148 := position, tokenIndex
+						if !_rules[rulespace]() {
+							goto l148
+						}
+						goto l147
+					l148:
+						position, tokenIndex = position148, tokenIndex148
+					}
+					goto l134
+				l133:
+					position, tokenIndex = position133, tokenIndex133
+				}
+			l134:
+				if !_rules[rulenewline_or_eot]() {
+					goto l129
+				}
+				add(ruletable_column, position130)
+			}
+			return true
+		l129:
+			position, tokenIndex = position129, tokenIndex129
+			return false
+		},
+		/* 14 column_name <- <(column_key* <string> Action8)> */
+		func() bool {
+			position149, tokenIndex149 := position, tokenIndex
+			{
+				position150 := position
+			l151:
+				{
+					position152, tokenIndex152 := position, tokenIndex
+					if !_rules[rulecolumn_key]() {
+						goto l152
+					}
+					goto l151
+				l152:
+					position, tokenIndex = position152, tokenIndex152
+				}
+				{
+					position153 := position
+					if !_rules[rulestring]() {
+						goto l149
+					}
+					add(rulePegText, position153)
+				}
+				if !_rules[ruleAction8]() {
+					goto l149
+				}
+				add(rulecolumn_name, position150)
+			}
+			return true
+		l149:
+			position, tokenIndex = position149, tokenIndex149
+			return false
+		},
+		/* 15 column_key <- <(('*' Action9) / ('+' Action10))> */
+		func() bool {
+			position154, tokenIndex154 := position, tokenIndex
+			{
+				position155 := position
+				{
+					position156, tokenIndex156 := position, tokenIndex
+					if buffer[position] != rune('*') {
+						goto l157
+					}
+					position++
+					if !_rules[ruleAction9]() {
+						goto l157
+					}
+					goto l156
+				l157:
+					position, tokenIndex = position156, tokenIndex156
+					if buffer[position] != rune('+') {
+						goto l154
+					}
+					position++
+					if !_rules[ruleAction10]() {
+						goto l154
+					}
+				}
+			l156:
+				add(rulecolumn_key, position155)
+			}
+			return true
+		l154:
+			position, tokenIndex = position154, tokenIndex154
+			return false
+		},
+		/* 16 column_definition <- <((space+ column_type)? (space+ column_constraint)*)> */
+		func() bool {
+			{
+				position159 := position
+				{
+					position160, tokenIndex160 := position, tokenIndex
+					if !_rules[rulespace]() {
+						goto l160
+					}
+				l162:
+					{
+						position163, tokenIndex163 := position, tokenIndex
+						if !_rules[rulespace]() {
+							goto l163
+						}
+						goto l162
+					l163:
+						position, tokenIndex = position163, tokenIndex163
+					}
+					if !_rules[rulecolumn_type]() {
+						goto l160
+					}
+					goto l161
+				l160:
+					position, tokenIndex = position160, tokenIndex160
+				}
+			l161:
+			l164:
+				{
+					position165, tokenIndex165 := position, tokenIndex
+					if !_rules[rulespace]() {
+						goto l165
+					}
+				l166:
+					{
+						position167, tokenIndex167 := position, tokenIndex
+						if !_rules[rulespace]() {
+							goto l167
+						}
+						goto l166
+					l167:
+						position, tokenIndex = position167, tokenIndex167
+					}
+					if !_rules[rulecolumn_constraint]() {
+						goto l165
+					}
+					goto l164
+				l165:
+					position, tokenIndex = position165, tokenIndex165
+				}
+				add(rulecolumn_definition, position159)
+			}
+			return true
+		},
+		/* 17 column_type <- <(!column_keyword <(type_string ('(' (!(')' / '\r' / '\n') .)* ')')? ('[' ']')*)> Action11)> */
+		func() bool {
+			position168, tokenIndex168 := position, tokenIndex
+			{
+				position169 := position
+				{
+					position170, tokenIndex170 := position, tokenIndex
+					if !_rules[rulecolumn_keyword]() {
+						goto l170
+					}
+					goto l168
+				l170:
+					position, tokenIndex = position170, tokenIndex170
+				}
+				{
+					position171 := position
+					if !_rules[ruletype_string]() {
+						goto l168
+					}
+					{
+						position172, tokenIndex172 := position, tokenIndex
+						if buffer[position] != rune('(') {
+							goto l172
+						}
+						position++
+					l174:
+						{
+							position175, tokenIndex175 := position, tokenIndex
+							{
+								position176, tokenIndex176 := position, tokenIndex
+								{
+									position177, tokenIndex177 := position, tokenIndex
+									if buffer[position] != rune(')') {
+										goto l178
+									}
+									position++
+									goto l177
+								l178:
+									position, tokenIndex = position177, tokenIndex177
+									if buffer[position] != rune('\r') {
+										goto l179
+									}
+									position++
+									goto l177
+								l179:
+									position, tokenIndex = position177, tokenIndex177
+									if buffer[position] != rune('\n') {
+										goto l176
+									}
+									position++
+								}
+							l177:
+								goto l175
+							l176:
+								position, tokenIndex = position176, tokenIndex176
+							}
+							if !matchDot() {
+								goto l175
+							}
+							goto l174
+						l175:
+							position, tokenIndex = position175, tokenIndex175
+						}
+						if buffer[position] != rune(')') {
+							goto l172
+						}
+						position++
+						goto l173
+					l172:
+						position, tokenIndex = position172, tokenIndex172
+					}
+				l173:
+				l180:
+					{
+						position181, tokenIndex181 := position, tokenIndex
+						if buffer[position] != rune('[') {
+							goto l181
+						}
+						position++
+						if buffer[position] != rune(']') {
+							goto l181
+						}
+						position++
+						goto l180
+					l181:
+						position, tokenIndex = position181, tokenIndex181
+					}
+					add(rulePegText, position171)
+				}
+				if !_rules[ruleAction11]() {
+					goto l168
+				}
+				add(rulecolumn_type, position169)
+			}
+			return true
+		l168:
+			position, tokenIndex = position168, tokenIndex168
+			return false
+		},
+		/* 18 column_constraint <- <(('n' 'o' 't' space+ ('n' 'u' 'l' 'l') Action12) / ('n' 'u' 'l' 'l' Action13) / ('d' 'e' 'f' 'a' 'u' 'l' 't' space+ <column_default> Action14))> */
+		func() bool {
+			position182, tokenIndex182 := position, tokenIndex
+			{
+				position183 := position
+				{
+					position184, tokenIndex184 := position, tokenIndex
+					if buffer[position] != rune('n') {
+						goto l185
+					}
+					position++
+					if buffer[position] != rune('o') {
+						goto l185
+					}
+					position++
+					if buffer[position] != rune('t') {
+						goto l185
+					}
+					position++
+					if !_rules[rulespace]() {
+						goto l185
+					}
+				l186:
+					{
+						position187, tokenIndex187 := position, tokenIndex
+						if !_rules[rulespace]() {
+							goto l187
+						}
+						goto l186
+					l187:
+						position, tokenIndex = position187, tokenIndex187
+					}
+					if buffer[position] != rune('n') {
+						goto l185
+					}
+					position++
+					if buffer[position] != rune('u') {
+						goto l185
+					}
+					position++
+					if buffer[position] != rune('l') {
+						goto l185
+					}
+					position++
+					if buffer[position] != rune('l') {
+						goto l185
+					}
+					position++
+					if !_rules[ruleAction12]() {
+						goto l185
+					}
+					goto l184
+				l185:
+					position, tokenIndex = position184, tokenIndex184
+					if buffer[position] != rune('n') {
+						goto l188
+					}
+					position++
+					if buffer[position] != rune('u') {
+						goto l188
+					}
+					position++
+					if buffer[position] != rune('l') {
+						goto l188
+					}
+					position++
+					if buffer[position] != rune('l') {
+						goto l188
+					}
+					position++
+					if !_rules[ruleAction13]() {
+						goto l188
+					}
+					goto l184
+				l188:
+					position, tokenIndex = position184, tokenIndex184
+					if buffer[position] != rune('d') {
+						goto l182
+					}
+					position++
+					if buffer[position] != rune('e') {
+						goto l182
+					}
+					position++
+					if buffer[position] != rune('f') {
+						goto l182
+					}
+					position++
+					if buffer[position] != rune('a') {
+						goto l182
+					}
+					position++
+					if buffer[position] != rune('u') {
+						goto l182
+					}
+					position++
+					if buffer[position] != rune('l') {
+						goto l182
+					}
+					position++
+					if buffer[position] != rune('t') {
+						goto l182
+					}
+					position++
+					if !_rules[rulespace]() {
+						goto l182
+					}
+				l189:
+					{
+						position190, tokenIndex190 := position, tokenIndex
+						if !_rules[rulespace]() {
+							goto l190
+						}
+						goto l189
+					l190:
+						position, tokenIndex = position190, tokenIndex190
+					}
+					{
+						position191 := position
+						if !_rules[rulecolumn_default]() {
+							goto l182
+						}
+						add(rulePegText, position191)
+					}
+					if !_rules[ruleAction14]() {
+						goto l182
+					}
+				}
+			l184:
+				add(rulecolumn_constraint, position183)
+			}
+			return true
+		l182:
+			position, tokenIndex = position182, tokenIndex182
+			return false
+		},
+		/* 19 column_keyword <- <((('n' 'o' 't') / ('n' 'u' 'l' 'l') / ('d' 'e' 'f' 'a' 'u' 'l' 't')) !(!('"' / '\t' / '\r' / '\n' / '/' / ':' / ',' / '(' / ')' / '[' / ']' / '{' / '}' / ' ') .))> */
+		func() bool {
+			position192, tokenIndex192 := position, tokenIndex
+			{
+				position193 := position
+				{
+					position194, tokenIndex194 := position, tokenIndex
+					if buffer[position] != rune('n') {
+						goto l195
+					}
+					position++
+					if buffer[position] != rune('o') {
+						goto l195
+					}
+					position++
+					if buffer[position] != rune('t') {
+						goto l195
+					}
+					position++
+					goto l194
+				l195:
+					position, tokenIndex = position194, tokenIndex194
+					if buffer[position] != rune('n') {
+						goto l196
+					}
+					position++
+					if buffer[position] != rune('u') {
+						goto l196
+					}
+					position++
+					if buffer[position] != rune('l') {
+						goto l196
+					}
+					position++
+					if buffer[position] != rune('l') {
+						goto l196
+					}
+					position++
+					goto l194
+				l196:
+					position, tokenIndex = position194, tokenIndex194
+					if buffer[position] != rune('d') {
+						goto l192
+					}
+					position++
+					if buffer[position] != rune('e') {
+						goto l192
+					}
+					position++
+					if buffer[position] != rune('f') {
+						goto l192
+					}
+					position++
+					if buffer[position] != rune('a') {
+						goto l192
+					}
+					position++
+					if buffer[position] != rune('u') {
+						goto l192
+					}
+					position++
+					if buffer[position] != rune('l') {
+						goto l192
+					}
+					position++
+					if buffer[position] != rune('t') {
+						goto l192
+					}
+					position++
+				}
+			l194:
+				{
+					position197, tokenIndex197 := position, tokenIndex
+					{
+						position198, tokenIndex198 := position, tokenIndex
+						{
+							position199, tokenIndex199 := position, tokenIndex
+							if buffer[position] != rune('"') {
+								goto l200
+							}
+							position++
+							goto l199
+						l200:
+							position, tokenIndex = position199, tokenIndex199
+							if buffer[position] != rune('\t') {
+								goto l201
+							}
+							position++
+							goto l199
+						l201:
+							position, tokenIndex = position199, tokenIndex199
+							if buffer[position] != rune('\r') {
+								goto l202
+							}
+							position++
+							goto l199
+						l202:
+							position, tokenIndex = position199, tokenIndex199
+							if buffer[position] != rune('\n') {
+								goto l203
+							}
+							position++
+							goto l199
+						l203:
+							position, tokenIndex = position199, tokenIndex199
+							if buffer[position] != rune('/') {
+								goto l204
+							}
+							position++
+							goto l199
+						l204:
+							position, tokenIndex = position199, tokenIndex199
+							if buffer[position] != rune(':') {
+								goto l205
+							}
+							position++
+							goto l199
+						l205:
+							position, tokenIndex = position199, tokenIndex199
+							if buffer[position] != rune(',') {
+								goto l206
+							}
+							position++
+							goto l199
+						l206:
+							position, tokenIndex = position199, tokenIndex199
+							if buffer[position] != rune('(') {
+								goto l207
+							}
+							position++
+							goto l199
+						l207:
+							position, tokenIndex = position199, tokenIndex199
+							if buffer[position] != rune(')') {
+								goto l208
+							}
+							position++
+							goto l199
+						l208:
+							position, tokenIndex = position199, tokenIndex199
+							if buffer[position] != rune('[') {
+								goto l209
+							}
+							position++
+							goto l199
+						l209:
+							position, tokenIndex = position199, tokenIndex199
+							if buffer[position] != rune(']') {
+								goto l210
+							}
+							position++
+							goto l199
+						l210:
+							position, tokenIndex = position199, tokenIndex199
+							if buffer[position] != rune('{') {
+								goto l211
+							}
+							position++
+							goto l199
+						l211:
+							position, tokenIndex = position199, tokenIndex199
+							if buffer[position] != rune('}') {
+								goto l212
+							}
+							position++
+							goto l199
+						l212:
+							position, tokenIndex = position199, tokenIndex199
+							if buffer[position] != rune(' ') {
+								goto l198
+							}
+							position++
+						}
+					l199:
+						goto l197
+					l198:
+						position, tokenIndex = position198, tokenIndex198
+					}
+					if !matchDot() {
+						goto l197
+					}
+					goto l192
+				l197:
+					position, tokenIndex = position197, tokenIndex197
+				}
+				add(rulecolumn_keyword, position193)
+			}
+			return true
+		l192:
+			position, tokenIndex = position192, tokenIndex192
+			return false
+		},
+		/* 20 column_default <- <(('\'' (!('\'' / '\r' / '\n') .)* '\'') / (!(' ' / '\t' / '\r' / '\n' / '{' / '}' / ',') .)+)> */
+		func() bool {
+			position213, tokenIndex213 := position, tokenIndex
+			{
+				position214 := position
+				{
+					position215, tokenIndex215 := position, tokenIndex
+					if buffer[position] != rune('\'') {
+						goto l216
+					}
+					position++
+				l217:
+					{
+						position218, tokenIndex218 := position, tokenIndex
+						{
+							position219, tokenIndex219 := position, tokenIndex
+							{
+								position220, tokenIndex220 := position, tokenIndex
+								if buffer[position] != rune('\'') {
+									goto l221
+								}
+								position++
+								goto l220
+							l221:
+								position, tokenIndex = position220, tokenIndex220
+								if buffer[position] != rune('\r') {
+									goto l222
+								}
+								position++
+								goto l220
+							l222:
+								position, tokenIndex = position220, tokenIndex220
+								if buffer[position] != rune('\n') {
+									goto l219
+								}
+								position++
+							}
+						l220:
+							goto l218
+						l219:
+							position, tokenIndex = position219, tokenIndex219
+						}
+						if !matchDot() {
+							goto l218
+						}
+						goto l217
+					l218:
+						position, tokenIndex = position218, tokenIndex218
+					}
+					if buffer[position] != rune('\'') {
+						goto l216
+					}
+					position++
+					goto l215
+				l216:
+					position, tokenIndex = position215, tokenIndex215
+					{
+						position225, tokenIndex225 := position, tokenIndex
+						{
+							position226, tokenIndex226 := position, tokenIndex
+							if buffer[position] != rune(' ') {
+								goto l227
+							}
+							position++
+							goto l226
+						l227:
+							position, tokenIndex = position226, tokenIndex226
+							if buffer[position] != rune('\t') {
+								goto l228
+							}
+							position++
+							goto l226
+						l228:
+							position, tokenIndex = position226, tokenIndex226
+							if buffer[position] != rune('\r') {
+								goto l229
+							}
+							position++
+							goto l226
+						l229:
+							position, tokenIndex = position226, tokenIndex226
+							if buffer[position] != rune('\n') {
+								goto l230
+							}
+							position++
+							goto l226
+						l230:
+							position, tokenIndex = position226, tokenIndex226
+							if buffer[position] != rune('{') {
+								goto l231
+							}
+							position++
+							goto l226
+						l231:
+							position, tokenIndex = position226, tokenIndex226
+							if buffer[position] != rune('}') {
+								goto l232
+							}
+							position++
+							goto l226
+						l232:
+							position, tokenIndex = position226, tokenIndex226
+							if buffer[position] != rune(',') {
+								goto l225
+							}
+							position++
+						}
+					l226:
+						goto l213
+					l225:
+						position, tokenIndex = position225, tokenIndex225
+					}
+					if !matchDot() {
+						goto l213
+					}
+				l223:
+					{
+						position224, tokenIndex224 := position, tokenIndex
+						{
+							position233, tokenIndex233 := position, tokenIndex
+							{
+								position234, tokenIndex234 := position, tokenIndex
+								if buffer[position] != rune(' ') {
+									goto l235
+								}
+								position++
+								goto l234
+							l235:
+								position, tokenIndex = position234, tokenIndex234
+								if buffer[position] != rune('\t') {
+									goto l236
+								}
+								position++
+								goto l234
+							l236:
+								position, tokenIndex = position234, tokenIndex234
+								if buffer[position] != rune('\r') {
+									goto l237
+								}
+								position++
+								goto l234
+							l237:
+								position, tokenIndex = position234, tokenIndex234
+								if buffer[position] != rune('\n') {
+									goto l238
+								}
+								position++
+								goto l234
+							l238:
+								position, tokenIndex = position234, tokenIndex234
+								if buffer[position] != rune('{') {
+									goto l239
+								}
+								position++
+								goto l234
+							l239:
+								position, tokenIndex = position234, tokenIndex234
+								if buffer[position] != rune('}') {
+									goto l240
+								}
+								position++
+								goto l234
+							l240:
+								position, tokenIndex = position234, tokenIndex234
+								if buffer[position] != rune(',') {
+									goto l233
+								}
+								position++
+							}
+						l234:
+							goto l224
+						l233:
+							position, tokenIndex = position233, tokenIndex233
+						}
+						if !matchDot() {
+							goto l224
+						}
+						goto l223
+					l224:
+						position, tokenIndex = position224, tokenIndex224
+					}
+				}
+			l215:
+				add(rulecolumn_default, position214)
+			}
+			return true
+		l213:
+			position, tokenIndex = position213, tokenIndex213
+			return false
+		},
+		/* 21 table_index <- <(space* ('i' 'n' 'd' 'e' 'x') space+ index_name space* '(' space* index_column (attribute_sep index_column)* space* ')' (space* '{' ws* (index_attribute ws* attribute_sep?)* ws* '}')? space* newline_or_eot)> */
+		func() bool {
+			position241, tokenIndex241 := position, tokenIndex
+			{
+				position242 := position
+			l243:
+				{
+					position244, tokenIndex244 := position, tokenIndex
+					if !_rules[rulespace]() {
+						goto l244
+					}
+					goto l243
+				l244:
+					position, tokenIndex = position244, tokenIndex244
+				}
+				if buffer[position] != rune('i') {
+					goto l241
+				}
+				position++
+				if buffer[position] != rune('n') {
+					goto l241
+				}
+				position++
+				if buffer[position] != rune('d') {
+					goto l241
+				}
+				position++
+				if buffer[position] != rune('e') {
+					goto l241
+				}
+				position++
+				if buffer[position] != rune('x') {
+					goto l241
+				}
+				position++
+				if !_rules[rulespace]() {
+					goto l241
+				}
+			l245:
+				{
+					position246, tokenIndex246 := position, tokenIndex
+					if !_rules[rulespace]() {
+						goto l246
+					}
+					goto l245
+				l246:
+					position, tokenIndex = position246, tokenIndex246
+				}
+				if !_rules[ruleindex_name]() {
+					goto l241
+				}
+			l247:
+				{
+					position248, tokenIndex248 := position, tokenIndex
+					if !_rules[rulespace]() {
+						goto l248
+					}
+					goto l247
+				l248:
+					position, tokenIndex = position248, tokenIndex248
+				}
+				if buffer[position] != rune('(') {
+					goto l241
+				}
+				position++
+			l249:
+				{
+					position250, tokenIndex250 := position, tokenIndex
+					if !_rules[rulespace]() {
+						goto l250
+					}
+					goto l249
+				l250:
+					position, tokenIndex = position250, tokenIndex250
+				}
+				if !_rules[ruleindex_column]() {
+					goto l241
+				}
+			l251:
+				{
+					position252, tokenIndex252 := position, tokenIndex
+					if !_rules[ruleattribute_sep]() {
+						goto l252
+					}
+					if !_rules[ruleindex_column]() {
+						goto l252
+					}
+					goto l251
+				l252:
+					position, tokenIndex = position252, tokenIndex252
+				}
+			l253:
+				{
+					position254, tokenIndex254 := position, tokenIndex
+					if !_rules[rulespace]() {
+						goto l254
+					}
+					goto l253
+				l254:
+					position, tokenIndex = position254, tokenIndex254
+				}
+				if buffer[position] != rune(')') {
+					goto l241
+				}
+				position++
+				{
+					position255, tokenIndex255 := position, tokenIndex
+				l257:
+					{
+						position258, tokenIndex258 := position, tokenIndex
+						if !_rules[rulespace]() {
+							goto l258
+						}
+						goto l257
+					l258:
+						position, tokenIndex = position258, tokenIndex258
+					}
+					if buffer[position] != rune('{') {
+						goto l255
+					}
+					position++
+				l259:
+					{
+						position260, tokenIndex260 := position, tokenIndex
+						if !_rules[rulews]() {
+							goto l260
+						}
+						goto l259
+					l260:
+						position, tokenIndex = position260, tokenIndex260
+					}
+				l261:
+					{
+						position262, tokenIndex262 := position, tokenIndex
+						if !_rules[ruleindex_attribute]() {
+							goto l262
+						}
+					l263:
+						{
+							position264, tokenIndex264 := position, tokenIndex
+							if !_rules[rulews]() {
+								goto l264
+							}
+							goto l263
+						l264:
+							position, tokenIndex = position264, tokenIndex264
+						}
+						{
+							position265, tokenIndex265 := position, tokenIndex
+							if !_rules[ruleattribute_sep]() {
+								goto l265
+							}
+							goto l266
+						l265:
+							position, tokenIndex = position265, tokenIndex265
+						}
+					l266:
+						goto l261
+					l262:
+						position, tokenIndex = position262, tokenIndex262
+					}
+				l267:
+					{
+						position268, tokenIndex268 := position, tokenIndex
+						if !_rules[rulews]() {
+							goto l268
+						}
+						goto l267
+					l268:
+						position, tokenIndex = position268, tokenIndex268
+					}
+					if buffer[position] != rune('}') {
+						goto l255
+					}
+					position++
+					goto l256
+				l255:
+					position, tokenIndex = position255, tokenIndex255
+				}
+			l256:
+			l269:
+				{
+					position270, tokenIndex270 := position, tokenIndex
+					if !_rules[rulespace]() {
+						goto l270
+					}
+					goto l269
+				l270:
+					position, tokenIndex = position270, tokenIndex270
+				}
+				if !_rules[rulenewline_or_eot]() {
+					goto l241
+				}
+				add(ruletable_index, position242)
+			}
+			return true
+		l241:
+			position, tokenIndex = position241, tokenIndex241
+			return false
+		},
+		/* 22 index_name <- <(<index_string> Action15)> */
+		func() bool {
+			position271, tokenIndex271 := position, tokenIndex
+			{
+				position272 := position
+				{
+					position273 := position
+					if !_rules[ruleindex_string]() {
+						goto l271
+					}
+					add(rulePegText, position273)
+				}
+				if !_rules[ruleAction15]() {
+					goto l271
+				}
+				add(ruleindex_name, position272)
+			}
+			return true
+		l271:
+			position, tokenIndex = position271, tokenIndex271
+			return false
+		},
+		/* 23 index_column <- <(<index_string> Action16)> */
+		func() bool {
+			position274, tokenIndex274 := position, tokenIndex
+			{
+				position275 := position
+				{
+					position276 := position
+					if !_rules[ruleindex_string]() {
+						goto l274
+					}
+					add(rulePegText, position276)
+				}
+				if !_rules[ruleAction16]() {
+					goto l274
+				}
+				add(ruleindex_column, position275)
+			}
+			return true
+		l274:
+			position, tokenIndex = position274, tokenIndex274
+			return false
+		},
+		/* 24 relation_info <- <(space* relation_left space* cardinality_left ('-' '-') cardinality_right space* relation_right (ws* '{' ws* (relation_attribute ws* attribute_sep? ws*)* ws* '}')? newline_or_eot Action17)> */
+		func() bool {
+			position277, tokenIndex277 := position, tokenIndex
+			{
+				position278 := position
+			l279:
+				{
+					position280, tokenIndex280 := position, tokenIndex
+					if !_rules[rulespace]() {
+						goto l280
+					}
+					goto l279
+				l280:
+					position, tokenIndex = position280, tokenIndex280
+				}
+				if !_rules[rulerelation_left]() {
+					goto l277
+				}
+			l281:
+				{
+					position282, tokenIndex282 := position, tokenIndex
+					if !_rules[rulespace]() {
+						goto l282
+					}
+					goto l281
+				l282:
+					position, tokenIndex = position282, tokenIndex282
+				}
+				if !_rules[rulecardinality_left]() {
+					goto l277
+				}
+				if buffer[position] != rune('-') {
+					goto l277
+				}
+				position++
+				if buffer[position] != rune('-') {
+					goto l277
+				}
+				position++
+				if !_rules[rulecardinality_right]() {
+					goto l277
+				}
+			l283:
+				{
+					position284, tokenIndex284 := position, tokenIndex
+					if !_rules[rulespace]() {
+						goto l284
+					}
+					goto l283
+				l284:
+					position, tokenIndex = position284, tokenIndex284
+				}
+				if !_rules[rulerelation_right]() {
+					goto l277
+				}
+				{
+					position285, tokenIndex285 := position, tokenIndex
+				l287:
+					{
+						position288, tokenIndex288 := position, tokenIndex
+						if !_rules[rulews]() {
+							goto l288
+						}
+						goto l287
+					l288:
+						position, tokenIndex = position288, tokenIndex288
+					}
+					if buffer[position] != rune('{') {
+						goto l285
+					}
+					position++
+				l289:
+					{
+						position290, tokenIndex290 := position, tokenIndex
+						if !_rules[rulews]() {
+							goto l290
+						}
+						goto l289
+					l290:
+						position, tokenIndex = position290, tokenIndex290
+					}
+				l291:
+					{
+						position292, tokenIndex292 := position, tokenIndex
+						if !_rules[rulerelation_attribute]() {
+							goto l292
+						}
+					l293:
+						{
+							position294, tokenIndex294 := position, tokenIndex
+							if !_rules[rulews]() {
+								goto l294
+							}
+							goto l293
+						l294:
+							position, tokenIndex = position294, tokenIndex294
+						}
+						{
+							position295, tokenIndex295 := position, tokenIndex
+							if !_rules[ruleattribute_sep]() {
+								goto l295
+							}
+							goto l296
+						l295:
+							position, tokenIndex = position295, tokenIndex295
+						}
+					l296:
+					l297:
+						{
+							position298, tokenIndex298 := position, tokenIndex
+							if !_rules[rulews]() {
+								goto l298
+							}
+							goto l297
+						l298:
+							position, tokenIndex = position298, tokenIndex298
+						}
+						goto l291
+					l292:
+						position, tokenIndex = position292, tokenIndex292
+					}
+				l299:
+					{
+						position300, tokenIndex300 := position, tokenIndex
+						if !_rules[rulews]() {
+							goto l300
+						}
+						goto l299
+					l300:
+						position, tokenIndex = position300, tokenIndex300
+					}
+					if buffer[position] != rune('}') {
+						goto l285
+					}
+					position++
+					goto l286
+				l285:
+					position, tokenIndex = position285, tokenIndex285
+				}
+			l286:
+				if !_rules[rulenewline_or_eot]() {
+					goto l277
+				}
+				if !_rules[ruleAction17]() {
+					goto l277
+				}
+				add(rulerelation_info, position278)
+			}
+			return true
+		l277:
+			position, tokenIndex = position277, tokenIndex277
+			return false
+		},
+		/* 25 relation_left <- <(((<quoted_name> Action18) / (<relation_name> Action19)) ('.' ((<quoted_name> Action20) / (<relation_name> Action21)))?)> */
+		func() bool {
+			position301, tokenIndex301 := position, tokenIndex
+			{
+				position302 := position
+				{
+					position303, tokenIndex303 := position, tokenIndex
+					{
+						position305 := position
+						if !_rules[rulequoted_name]() {
+							goto l304
+						}
+						add(rulePegText, position305)
+					}
+					if !_rules[ruleAction18]() {
+						goto l304
+					}
+					goto l303
+				l304:
+					position, tokenIndex = position303, tokenIndex303
+					{
+						position306 := position
+						if !_rules[rulerelation_name]() {
+							goto l301
+						}
+						add(rulePegText, position306)
+					}
+					if !_rules[ruleAction19]() {
+						goto l301
+					}
+				}
+			l303:
+				{
+					position307, tokenIndex307 := position, tokenIndex
+					if buffer[position] != rune('.') {
+						goto l307
+					}
+					position++
+					{
+						position309, tokenIndex309 := position, tokenIndex
+						{
+							position311 := position
+							if !_rules[rulequoted_name]() {
+								goto l310
+							}
+							add(rulePegText, position311)
+						}
+						if !_rules[ruleAction20]() {
+							goto l310
+						}
+						goto l309
+					l310:
+						position, tokenIndex = position309, tokenIndex309
+						{
+							position312 := position
+							if !_rules[rulerelation_name]() {
+								goto l307
+							}
+							add(rulePegText, position312)
+						}
+						if !_rules[ruleAction21]() {
+							goto l307
+						}
+					}
+				l309:
+					goto l308
+				l307:
+					position, tokenIndex = position307, tokenIndex307
+				}
+			l308:
+				add(rulerelation_left, position302)
+			}
+			return true
+		l301:
+			position, tokenIndex = position301, tokenIndex301
+			return false
+		},
+		/* 26 cardinality_left <- <(<cardinality> Action22)> */
+		func() bool {
+			position313, tokenIndex313 := position, tokenIndex
+			{
+				position314 := position
+				{
+					position315 := position
+					if !_rules[rulecardinality]() {
+						goto l313
+					}
+					add(rulePegText, position315)
+				}
+				if !_rules[ruleAction22]() {
+					goto l313
+				}
+				add(rulecardinality_left, position314)
+			}
+			return true
+		l313:
+			position, tokenIndex = position313, tokenIndex313
+			return false
+		},
+		/* 27 relation_right <- <(((<quoted_name> Action23) / (<relation_name> Action24)) ('.' ((<quoted_name> Action25) / (<relation_name> Action26)))?)> */
+		func() bool {
+			position316, tokenIndex316 := position, tokenIndex
+			{
+				position317 := position
+				{
+					position318, tokenIndex318 := position, tokenIndex
+					{
+						position320 := position
+						if !_rules[rulequoted_name]() {
+							goto l319
+						}
+						add(rulePegText, position320)
+					}
+					if !_rules[ruleAction23]() {
+						goto l319
+					}
+					goto l318
+				l319:
+					position, tokenIndex = position318, tokenIndex318
+					{
+						position321 := position
+						if !_rules[rulerelation_name]() {
+							goto l316
+						}
+						add(rulePegText, position321)
+					}
+					if !_rules[ruleAction24]() {
+						goto l316
+					}
+				}
+			l318:
+				{
+					position322, tokenIndex322 := position, tokenIndex
+					if buffer[position] != rune('.') {
+						goto l322
+					}
+					position++
+					{
+						position324, tokenIndex324 := position, tokenIndex
+						{
+							position326 := position
+							if !_rules[rulequoted_name]() {
+								goto l325
+							}
+							add(rulePegText, position326)
+						}
+						if !_rules[ruleAction25]() {
+							goto l325
+						}
+						goto l324
+					l325:
+						position, tokenIndex = position324, tokenIndex324
+						{
+							position327 := position
+							if !_rules[rulerelation_name]() {
+								goto l322
+							}
+							add(rulePegText, position327)
+						}
+						if !_rules[ruleAction26]() {
+							goto l322
+						}
+					}
+				l324:
+					goto l323
+				l322:
+					position, tokenIndex = position322, tokenIndex322
+				}
+			l323:
+				add(rulerelation_right, position317)
+			}
+			return true
+		l316:
+			position, tokenIndex = position316, tokenIndex316
+			return false
+		},
+		/* 28 cardinality_right <- <(<cardinality> Action27)> */
+		func() bool {
+			position328, tokenIndex328 := position, tokenIndex
+			{
+				position329 := position
+				{
+					position330 := position
+					if !_rules[rulecardinality]() {
+						goto l328
+					}
+					add(rulePegText, position330)
+				}
+				if !_rules[ruleAction27]() {
+					goto l328
+				}
+				add(rulecardinality_right, position329)
+			}
+			return true
+		l328:
+			position, tokenIndex = position328, tokenIndex328
+			return false
+		},
+		/* 29 title_attribute <- <(attribute_key space* ':' space* attribute_value Action28)> */
+		func() bool {
+			position331, tokenIndex331 := position, tokenIndex
+			{
+				position332 := position
+				if !_rules[ruleattribute_key]() {
+					goto l331
+				}
+			l333:
+				{
+					position334, tokenIndex334 := position, tokenIndex
+					if !_rules[rulespace]() {
+						goto l334
+					}
+					goto l333
+				l334:
+					position, tokenIndex = position334, tokenIndex334
+				}
+				if buffer[position] != rune(':') {
+					goto l331
+				}
+				position++
+			l335:
+				{
+					position336, tokenIndex336 := position, tokenIndex
+					if !_rules[rulespace]() {
+						goto l336
+					}
+					goto l335
+				l336:
+					position, tokenIndex = position336, tokenIndex336
+				}
+				if !_rules[ruleattribute_value]() {
+					goto l331
+				}
+				if !_rules[ruleAction28]() {
+					goto l331
+				}
+				add(ruletitle_attribute, position332)
+			}
+			return true
+		l331:
+			position, tokenIndex = position331, tokenIndex331
+			return false
+		},
+		/* 30 group_attribute <- <(attribute_key space* ':' space* attribute_value Action29)> */
+		func() bool {
+			position337, tokenIndex337 := position, tokenIndex
+			{
+				position338 := position
+				if !_rules[ruleattribute_key]() {
+					goto l337
+				}
+			l339:
+				{
+					position340, tokenIndex340 := position, tokenIndex
+					if !_rules[rulespace]() {
+						goto l340
+					}
+					goto l339
+				l340:
+					position, tokenIndex = position340, tokenIndex340
+				}
+				if buffer[position] != rune(':') {
+					goto l337
+				}
+				position++
+			l341:
+				{
+					position342, tokenIndex342 := position, tokenIndex
+					if !_rules[rulespace]() {
+						goto l342
+					}
+					goto l341
+				l342:
+					position, tokenIndex = position342, tokenIndex342
+				}
+				if !_rules[ruleattribute_value]() {
+					goto l337
+				}
+				if !_rules[ruleAction29]() {
+					goto l337
+				}
+				add(rulegroup_attribute, position338)
+			}
+			return true
+		l337:
+			position, tokenIndex = position337, tokenIndex337
+			return false
+		},
+		/* 31 table_attribute <- <(attribute_key space* ':' space* attribute_value Action30)> */
+		func() bool {
+			position343, tokenIndex343 := position, tokenIndex
+			{
+				position344 := position
+				if !_rules[ruleattribute_key]() {
+					goto l343
+				}
+			l345:
+				{
+					position346, tokenIndex346 := position, tokenIndex
+					if !_rules[rulespace]() {
+						goto l346
+					}
+					goto l345
+				l346:
+					position, tokenIndex = position346, tokenIndex346
+				}
+				if buffer[position] != rune(':') {
+					goto l343
+				}
+				position++
+			l347:
+				{
+					position348, tokenIndex348 := position, tokenIndex
+					if !_rules[rulespace]() {
+						goto l348
+					}
+					goto l347
+				l348:
+					position, tokenIndex = position348, tokenIndex348
+				}
+				if !_rules[ruleattribute_value]() {
+					goto l343
+				}
+				if !_rules[ruleAction30]() {
+					goto l343
+				}
+				add(ruletable_attribute, position344)
+			}
+			return true
+		l343:
+			position, tokenIndex = position343, tokenIndex343
+			return false
+		},
+		/* 32 column_attribute <- <(attribute_key space* ':' space* attribute_value Action31)> */
+		func() bool {
+			position349, tokenIndex349 := position, tokenIndex
+			{
+				position350 := position
+				if !_rules[ruleattribute_key]() {
+					goto l349
+				}
+			l351:
+				{
+					position352, tokenIndex352 := position, tokenIndex
+					if !_rules[rulespace]() {
+						goto l352
+					}
+					goto l351
+				l352:
+					position, tokenIndex = position352, tokenIndex352
+				}
+				if buffer[position] != rune(':') {
+					goto l349
+				}
+				position++
+			l353:
+				{
+					position354, tokenIndex354 := position, tokenIndex
+					if !_rules[rulespace]() {
+						goto l354
+					}
+					goto l353
+				l354:
+					position, tokenIndex = position354, tokenIndex354
+				}
+				if !_rules[ruleattribute_value]() {
+					goto l349
+				}
+				if !_rules[ruleAction31]() {
+					goto l349
+				}
+				add(rulecolumn_attribute, position350)
+			}
+			return true
+		l349:
+			position, tokenIndex = position349, tokenIndex349
+			return false
+		},
+		/* 33 relation_attribute <- <(attribute_key space* ':' space* attribute_value Action32)> */
+		func() bool {
+			position355, tokenIndex355 := position, tokenIndex
+			{
+				position356 := position
+				if !_rules[ruleattribute_key]() {
+					goto l355
+				}
+			l357:
+				{
+					position358, tokenIndex358 := position, tokenIndex
+					if !_rules[rulespace]() {
+						goto l358
+					}
+					goto l357
+				l358:
+					position, tokenIndex = position358, tokenIndex358
+				}
+				if buffer[position] != rune(':') {
+					goto l355
+				}
+				position++
+			l359:
+				{
+					position360, tokenIndex360 := position, tokenIndex
+					if !_rules[rulespace]() {
+						goto l360
+					}
+					goto l359
+				l360:
+					position, tokenIndex = position360, tokenIndex360
+				}
+				if !_rules[ruleattribute_value]() {
+					goto l355
+				}
+				if !_rules[ruleAction32]() {
+					goto l355
+				}
+				add(rulerelation_attribute, position356)
+			}
+			return true
+		l355:
+			position, tokenIndex = position355, tokenIndex355
+			return false
+		},
+		/* 34 index_attribute <- <(attribute_key space* ':' space* attribute_value Action33)> */
+		func() bool {
+			position361, tokenIndex361 := position, tokenIndex
+			{
+				position362 := position
+				if !_rules[ruleattribute_key]() {
+					goto l361
+				}
+			l363:
+				{
+					position364, tokenIndex364 := position, tokenIndex
+					if !_rules[rulespace]() {
+						goto l364
+					}
+					goto l363
+				l364:
+					position, tokenIndex = position364, tokenIndex364
+				}
+				if buffer[position] != rune(':') {
+					goto l361
+				}
+				position++
+			l365:
+				{
+					position366, tokenIndex366 := position, tokenIndex
+					if !_rules[rulespace]() {
+						goto l366
+					}
+					goto l365
+				l366:
+					position, tokenIndex = position366, tokenIndex366
+				}
+				if !_rules[ruleattribute_value]() {
+					goto l361
+				}
+				if !_rules[ruleAction33]() {
+					goto l361
+				}
+				add(ruleindex_attribute, position362)
+			}
+			return true
+		l361:
+			position, tokenIndex = position361, tokenIndex361
+			return false
+		},
+		/* 35 attribute_key <- <(<string> Action34)> */
+		func() bool {
+			position367, tokenIndex367 := position, tokenIndex
+			{
+				position368 := position
+				{
+					position369 := position
+					if !_rules[rulestring]() {
+						goto l367
+					}
+					add(rulePegText, position369)
+				}
+				if !_rules[ruleAction34]() {
+					goto l367
+				}
+				add(ruleattribute_key, position368)
+			}
+			return true
+		l367:
+			position, tokenIndex = position367, tokenIndex367
+			return false
+		},
+		/* 36 attribute_value <- <(bare_value / quoted_value)> */
+		func() bool {
+			position370, tokenIndex370 := position, tokenIndex
+			{
+				position371 := position
+				{
+					position372, tokenIndex372 := position, tokenIndex
+					if !_rules[rulebare_value]() {
+						goto l373
+					}
+					goto l372
+				l373:
+					position, tokenIndex = position372, tokenIndex372
+					if !_rules[rulequoted_value]() {
+						goto l370
+					}
+				}
+			l372:
+				add(ruleattribute_value, position371)
+			}
+			return true
+		l370:
+			position, tokenIndex = position370, tokenIndex370
+			return false
+		},
+		/* 37 bare_value <- <(<string> Action35)> */
+		func() bool {
+			position374, tokenIndex374 := position, tokenIndex
+			{
+				position375 := position
+				{
+					position376 := position
+					if !_rules[rulestring]() {
+						goto l374
+					}
+					add(rulePegText, position376)
+				}
+				if !_rules[ruleAction35]() {
+					goto l374
+				}
+				add(rulebare_value, position375)
+			}
+			return true
+		l374:
+			position, tokenIndex = position374, tokenIndex374
+			return false
+		},
+		/* 38 quoted_value <- <(<('"' string_in_quote '"')> Action36)> */
+		func() bool {
+			position377, tokenIndex377 := position, tokenIndex
+			{
+				position378 := position
+				{
+					position379 := position
+					if buffer[position] != rune('"') {
+						goto l377
+					}
+					position++
+					if !_rules[rulestring_in_quote]() {
+						goto l377
+					}
+					if buffer[position] != rune('"') {
+						goto l377
+					}
+					position++
+					add(rulePegText, position379)
+				}
+				if !_rules[ruleAction36]() {
+					goto l377
+				}
+				add(rulequoted_value, position378)
+			}
+			return true
+		l377:
+			position, tokenIndex = position377, tokenIndex377
+			return false
+		},
+		/* 39 attribute_sep <- <(space* ',' space*)> */
+		func() bool {
+			position380, tokenIndex380 := position, tokenIndex
+			{
+				position381 := position
+			l382:
+				{
+					position383, tokenIndex383 := position, tokenIndex
+					if !_rules[rulespace]() {
+						goto l383
+					}
+					goto l382
+				l383:
+					position, tokenIndex = position383, tokenIndex383
+				}
+				if buffer[position] != rune(',') {
+					goto l380
+				}
+				position++
+			l384:
+				{
+					position385, tokenIndex385 := position, tokenIndex
+					if !_rules[rulespace]() {
+						goto l385
+					}
+					goto l384
+				l385:
+					position, tokenIndex = position385, tokenIndex385
+				}
+				add(ruleattribute_sep, position381)
+			}
+			return true
+		l380:
+			position, tokenIndex = position380, tokenIndex380
+			return false
+		},
+		/* 40 comment_string <- <(!('\r' / '\n') .)*> */
+		func() bool {
+			{
+				position387 := position
+			l388:
+				{
+					position389, tokenIndex389 := position, tokenIndex
+					{
+						position390, tokenIndex390 := position, tokenIndex
+						{
+							position391, tokenIndex391 := position, tokenIndex
+							if buffer[position] != rune('\r') {
+								goto l392
+							}
+							position++
+							goto l391
+						l392:
+							position, tokenIndex = position391, tokenIndex391
+							if buffer[position] != rune('\n') {
+								goto l390
+							}
+							position++
+						}
+					l391:
+						goto l389
+					l390:
+						position, tokenIndex = position390, tokenIndex390
+					}
+					if !matchDot() {
+						goto l389
+					}
+					goto l388
+				l389:
+					position, tokenIndex = position389, tokenIndex389
+				}
+				add(rulecomment_string, position387)
+			}
+			return true
+		},
+		/* 41 ws <- <(' ' / '\t' / '\r' / '\n')+> */
+		func() bool {
+			position393, tokenIndex393 := position, tokenIndex
+			{
+				position394 := position
+				{
+					position397, tokenIndex397 := position, tokenIndex
+					if buffer[position] != rune(' ') {
+						goto l398
+					}
+					position++
+					goto l397
+				l398:
+					position, tokenIndex = position397, tokenIndex397
+					if buffer[position] != rune('\t') {
+						goto l399
+					}
+					position++
+					goto l397
+				l399:
+					position, tokenIndex = position397, tokenIndex397
+					if buffer[position] != rune('\r') {
+						goto l400
+					}
+					position++
+					goto l397
+				l400:
+					position, tokenIndex = position397, tokenIndex397
+					if buffer[position] != rune('\n') {
+						goto l393
+					}
+					position++
+				}
+			l397:
+			l395:
+				{
+					position396, tokenIndex396 := position, tokenIndex
+					{
+						position401, tokenIndex401 := position, tokenIndex
+						if buffer[position] != rune(' ') {
+							goto l402
+						}
+						position++
+						goto l401
+					l402:
+						position, tokenIndex = position401, tokenIndex401
+						if buffer[position] != rune('\t') {
+							goto l403
+						}
+						position++
+						goto l401
+					l403:
+						position, tokenIndex = position401, tokenIndex401
+						if buffer[position] != rune('\r') {
+							goto l404
+						}
+						position++
+						goto l401
+					l404:
+						position, tokenIndex = position401, tokenIndex401
+						if buffer[position] != rune('\n') {
+							goto l396
+						}
+						position++
+					}
+				l401:
+					goto l395
+				l396:
+					position, tokenIndex = position396, tokenIndex396
+				}
+				add(rulews, position394)
+			}
+			return true
+		l393:
+			position, tokenIndex = position393, tokenIndex393
+			return false
+		},
+		/* 42 newline <- <(('\r' '\n') / '\n' / '\r')> */
+		func() bool {
+			position405, tokenIndex405 := position, tokenIndex
+			{
+				position406 := position
+				{
+					position407, tokenIndex407 := position, tokenIndex
+					if buffer[position] != rune('\r') {
+						goto l408
+					}
+					position++
+					if buffer[position] != rune('\n') {
+						goto l408
+					}
+					position++
+					goto l407
+				l408:
+					position, tokenIndex = position407, tokenIndex407
+					if buffer[position] != rune('\n') {
+						goto l409
+					}
+					position++
+					goto l407
+				l409:
+					position, tokenIndex = position407, tokenIndex407
+					if buffer[position] != rune('\r') {
+						goto l405
+					}
+					position++
+				}
+			l407:
+				add(rulenewline, position406)
+			}
+			return true
+		l405:
+			position, tokenIndex = position405, tokenIndex405
+			return false
+		},
+		/* 43 newline_or_eot <- <(newline / EOT)> */
+		func() bool {
+			position410, tokenIndex410 := position, tokenIndex
+			{
+				position411 := position
+				{
+					position412, tokenIndex412 := position, tokenIndex
+					if !_rules[rulenewline]() {
+						goto l413
+					}
+					goto l412
+				l413:
+					position, tokenIndex = position412, tokenIndex412
+					if !_rules[ruleEOT]() {
+						goto l410
+					}
+				}
+			l412:
+				add(rulenewline_or_eot, position411)
+			}
+			return true
+		l410:
+			position, tokenIndex = position410, tokenIndex410
+			return false
+		},
+		/* 44 space <- <(' ' / '\t')+> */
+		func() bool {
+			position414, tokenIndex414 := position, tokenIndex
+			{
+				position415 := position
+				{
+					position418, tokenIndex418 := position, tokenIndex
+					if buffer[position] != rune(' ') {
+						goto l419
+					}
+					position++
+					goto l418
+				l419:
+					position, tokenIndex = position418, tokenIndex418
+					if buffer[position] != rune('\t') {
+						goto l414
+					}
+					position++
+				}
+			l418:
+			l416:
+				{
+					position417, tokenIndex417 := position, tokenIndex
+					{
+						position420, tokenIndex420 := position, tokenIndex
+						if buffer[position] != rune(' ') {
+							goto l421
+						}
+						position++
+						goto l420
+					l421:
+						position, tokenIndex = position420, tokenIndex420
+						if buffer[position] != rune('\t') {
+							goto l417
+						}
+						position++
+					}
+				l420:
+					goto l416
+				l417:
+					position, tokenIndex = position417, tokenIndex417
+				}
+				add(rulespace, position415)
+			}
+			return true
+		l414:
+			position, tokenIndex = position414, tokenIndex414
+			return false
+		},
+		/* 45 string <- <(!('"' / '\t' / '\r' / '\n' / '/' / ':' / ',' / '[' / ']' / '{' / '}' / ' ') .)+> */
+		func() bool {
+			position422, tokenIndex422 := position, tokenIndex
+			{
+				position423 := position
+				{
+					position426, tokenIndex426 := position, tokenIndex
+					{
+						position427, tokenIndex427 := position, tokenIndex
+						if buffer[position] != rune('"') {
+							goto l428
+						}
+						position++
+						goto l427
+					l428:
+						position, tokenIndex = position427, tokenIndex427
+						if buffer[position] != rune('\t') {
+							goto l429
+						}
+						position++
+						goto l427
+					l429:
+						position, tokenIndex = position427, tokenIndex427
+						if buffer[position] != rune('\r') {
+							goto l430
+						}
+						position++
+						goto l427
+					l430:
+						position, tokenIndex = position427, tokenIndex427
+						if buffer[position] != rune('\n') {
+							goto l431
+						}
+						position++
+						goto l427
+					l431:
+						position, tokenIndex = position427, tokenIndex427
+						if buffer[position] != rune('/') {
+							goto l432
+						}
+						position++
+						goto l427
+					l432:
+						position, tokenIndex = position427, tokenIndex427
+						if buffer[position] != rune(':') {
+							goto l433
+						}
+						position++
+						goto l427
+					l433:
+						position, tokenIndex = position427, tokenIndex427
+						if buffer[position] != rune(',') {
+							goto l434
+						}
+						position++
+						goto l427
+					l434:
+						position, tokenIndex = position427, tokenIndex427
+						if buffer[position] != rune('[') {
+							goto l435
+						}
+						position++
+						goto l427
+					l435:
+						position, tokenIndex = position427, tokenIndex427
+						if buffer[position] != rune(']') {
+							goto l436
+						}
+						position++
+						goto l427
+					l436:
+						position, tokenIndex = position427, tokenIndex427
+						if buffer[position] != rune('{') {
+							goto l437
+						}
+						position++
+						goto l427
+					l437:
+						position, tokenIndex = position427, tokenIndex427
+						if buffer[position] != rune('}') {
+							goto l438
+						}
+						position++
+						goto l427
+					l438:
+						position, tokenIndex = position427, tokenIndex427
+						if buffer[position] != rune(' ') {
+							goto l426
+						}
+						position++
+					}
+				l427:
+					goto l422
+				l426:
+					position, tokenIndex = position426, tokenIndex426
+				}
+				if !matchDot() {
+					goto l422
+				}
+			l424:
+				{
+					position425, tokenIndex425 := position, tokenIndex
+					{
+						position439, tokenIndex439 := position, tokenIndex
+						{
+							position440, tokenIndex440 := position, tokenIndex
+							if buffer[position] != rune('"') {
+								goto l441
+							}
+							position++
+							goto l440
+						l441:
+							position, tokenIndex = position440, tokenIndex440
+							if buffer[position] != rune('\t') {
+								goto l442
+							}
+							position++
+							goto l440
+						l442:
+							position, tokenIndex = position440, tokenIndex440
+							if buffer[position] != rune('\r') {
+								goto l443
+							}
+							position++
+							goto l440
+						l443:
+							position, tokenIndex = position440, tokenIndex440
+							if buffer[position] != rune('\n') {
+								goto l444
+							}
+							position++
+							goto l440
+						l444:
+							position, tokenIndex = position440, tokenIndex440
+							if buffer[position] != rune('/') {
+								goto l445
+							}
+							position++
+							goto l440
+						l445:
+							position, tokenIndex = position440, tokenIndex440
+							if buffer[position] != rune(':') {
+								goto l446
+							}
+							position++
+							goto l440
+						l446:
+							position, tokenIndex = position440, tokenIndex440
+							if buffer[position] != rune(',') {
+								goto l447
+							}
+							position++
+							goto l440
+						l447:
+							position, tokenIndex = position440, tokenIndex440
+							if buffer[position] != rune('[') {
+								goto l448
+							}
+							position++
+							goto l440
+						l448:
+							position, tokenIndex = position440, tokenIndex440
+							if buffer[position] != rune(']') {
+								goto l449
+							}
+							position++
+							goto l440
+						l449:
+							position, tokenIndex = position440, tokenIndex440
+							if buffer[position] != rune('{') {
+								goto l450
+							}
+							position++
+							goto l440
+						l450:
+							position, tokenIndex = position440, tokenIndex440
+							if buffer[position] != rune('}') {
+								goto l451
+							}
+							position++
+							goto l440
+						l451:
+							position, tokenIndex = position440, tokenIndex440
+							if buffer[position] != rune(' ') {
+								goto l439
+							}
+							position++
+						}
+					l440:
+						goto l425
+					l439:
+						position, tokenIndex = position439, tokenIndex439
+					}
+					if !matchDot() {
+						goto l425
+					}
+					goto l424
+				l425:
+					position, tokenIndex = position425, tokenIndex425
+				}
+				add(rulestring, position423)
+			}
+			return true
+		l422:
+			position, tokenIndex = position422, tokenIndex422
+			return false
+		},
+		/* 46 string_in_quote <- <(!('"' / '\t' / '\r' / '\n') .)+> */
+		func() bool {
+			position452, tokenIndex452 := position, tokenIndex
+			{
+				position453 := position
+				{
+					position456, tokenIndex456 := position, tokenIndex
+					{
+						position457, tokenIndex457 := position, tokenIndex
+						if buffer[position] != rune('"') {
+							goto l458
+						}
+						position++
+						goto l457
+					l458:
+						position, tokenIndex = position457, tokenIndex457
+						if buffer[position] != rune('\t') {
+							goto l459
+						}
+						position++
+						goto l457
+					l459:
+						position, tokenIndex = position457, tokenIndex457
+						if buffer[position] != rune('\r') {
+							goto l460
+						}
+						position++
+						goto l457
+					l460:
+						position, tokenIndex = position457, tokenIndex457
+						if buffer[position] != rune('\n') {
+							goto l456
+						}
+						position++
+					}
+				l457:
+					goto l452
+				l456:
+					position, tokenIndex = position456, tokenIndex456
+				}
+				if !matchDot() {
+					goto l452
+				}
+			l454:
+				{
+					position455, tokenIndex455 := position, tokenIndex
+					{
+						position461, tokenIndex461 := position, tokenIndex
+						{
+							position462, tokenIndex462 := position, tokenIndex
+							if buffer[position] != rune('"') {
+								goto l463
+							}
+							position++
+							goto l462
+						l463:
+							position, tokenIndex = position462, tokenIndex462
+							if buffer[position] != rune('\t') {
+								goto l464
+							}
+							position++
+							goto l462
+						l464:
+							position, tokenIndex = position462, tokenIndex462
+							if buffer[position] != rune('\r') {
+								goto l465
+							}
+							position++
+							goto l462
+						l465:
+							position, tokenIndex = position462, tokenIndex462
+							if buffer[position] != rune('\n') {
+								goto l461
+							}
+							position++
+						}
+					l462:
+						goto l455
+					l461:
+						position, tokenIndex = position461, tokenIndex461
+					}
+					if !matchDot() {
+						goto l455
+					}
+					goto l454
+				l455:
+					position, tokenIndex = position455, tokenIndex455
+				}
+				add(rulestring_in_quote, position453)
+			}
+			return true
+		l452:
+			position, tokenIndex = position452, tokenIndex452
+			return false
+		},
+		/* 47 relation_name <- <(!('"' / '\t' / '\r' / '\n' / '/' / ':' / ',' / '.' / '[' / ']' / '{' / '}' / ' ') .)+> */
+		func() bool {
+			position466, tokenIndex466 := position, tokenIndex
+			{
+				position467 := position
+				{
+					position470, tokenIndex470 := position, tokenIndex
+					{
+						position471, tokenIndex471 := position, tokenIndex
+						if buffer[position] != rune('"') {
+							goto l472
+						}
+						position++
+						goto l471
+					l472:
+						position, tokenIndex = position471, tokenIndex471
+						if buffer[position] != rune('\t') {
+							goto l473
+						}
+						position++
+						goto l471
+					l473:
+						position, tokenIndex = position471, tokenIndex471
+						if buffer[position] != rune('\r') {
+							goto l474
+						}
+						position++
+						goto l471
+					l474:
+						position, tokenIndex = position471, tokenIndex471
+						if buffer[position] != rune('\n') {
+							goto l475
+						}
+						position++
+						goto l471
+					l475:
+						position, tokenIndex = position471, tokenIndex471
+						if buffer[position] != rune('/') {
+							goto l476
+						}
+						position++
+						goto l471
+					l476:
+						position, tokenIndex = position471, tokenIndex471
+						if buffer[position] != rune(':') {
+							goto l477
+						}
+						position++
+						goto l471
+					l477:
+						position, tokenIndex = position471, tokenIndex471
+						if buffer[position] != rune(',') {
+							goto l478
+						}
+						position++
+						goto l471
+					l478:
+						position, tokenIndex = position471, tokenIndex471
+						if buffer[position] != rune('.') {
+							goto l479
+						}
+						position++
+						goto l471
+					l479:
+						position, tokenIndex = position471, tokenIndex471
+						if buffer[position] != rune('[') {
+							goto l480
+						}
+						position++
+						goto l471
+					l480:
+						position, tokenIndex = position471, tokenIndex471
+						if buffer[position] != rune(']') {
+							goto l481
+						}
+						position++
+						goto l471
+					l481:
+						position, tokenIndex = position471, tokenIndex471
+						if buffer[position] != rune('{') {
+							goto l482
+						}
+						position++
+						goto l471
+					l482:
+						position, tokenIndex = position471, tokenIndex471
+						if buffer[position] != rune('}') {
+							goto l483
+						}
+						position++
+						goto l471
+					l483:
+						position, tokenIndex = position471, tokenIndex471
+						if buffer[position] != rune(' ') {
+							goto l470
+						}
+						position++
+					}
+				l471:
+					goto l466
+				l470:
+					position, tokenIndex = position470, tokenIndex470
+				}
+				if !matchDot() {
+					goto l466
+				}
+			l468:
+				{
+					position469, tokenIndex469 := position, tokenIndex
+					{
+						position484, tokenIndex484 := position, tokenIndex
+						{
+							position485, tokenIndex485 := position, tokenIndex
+							if buffer[position] != rune('"') {
+								goto l486
+							}
+							position++
+							goto l485
+						l486:
+							position, tokenIndex = position485, tokenIndex485
+							if buffer[position] != rune('\t') {
+								goto l487
+							}
+							position++
+							goto l485
+						l487:
+							position, tokenIndex = position485, tokenIndex485
+							if buffer[position] != rune('\r') {
+								goto l488
+							}
+							position++
+							goto l485
+						l488:
+							position, tokenIndex = position485, tokenIndex485
+							if buffer[position] != rune('\n') {
+								goto l489
+							}
+							position++
+							goto l485
+						l489:
+							position, tokenIndex = position485, tokenIndex485
+							if buffer[position] != rune('/') {
+								goto l490
+							}
+							position++
+							goto l485
+						l490:
+							position, tokenIndex = position485, tokenIndex485
+							if buffer[position] != rune(':') {
+								goto l491
+							}
+							position++
+							goto l485
+						l491:
+							position, tokenIndex = position485, tokenIndex485
+							if buffer[position] != rune(',') {
+								goto l492
+							}
+							position++
+							goto l485
+						l492:
+							position, tokenIndex = position485, tokenIndex485
+							if buffer[position] != rune('.') {
+								goto l493
+							}
+							position++
+							goto l485
+						l493:
+							position, tokenIndex = position485, tokenIndex485
+							if buffer[position] != rune('[') {
+								goto l494
+							}
+							position++
+							goto l485
+						l494:
+							position, tokenIndex = position485, tokenIndex485
+							if buffer[position] != rune(']') {
+								goto l495
+							}
+							position++
+							goto l485
+						l495:
+							position, tokenIndex = position485, tokenIndex485
+							if buffer[position] != rune('{') {
+								goto l496
+							}
+							position++
+							goto l485
+						l496:
+							position, tokenIndex = position485, tokenIndex485
+							if buffer[position] != rune('}') {
+								goto l497
+							}
+							position++
+							goto l485
+						l497:
+							position, tokenIndex = position485, tokenIndex485
+							if buffer[position] != rune(' ') {
+								goto l484
+							}
+							position++
+						}
+					l485:
+						goto l469
+					l484:
+						position, tokenIndex = position484, tokenIndex484
+					}
+					if !matchDot() {
+						goto l469
+					}
+					goto l468
+				l469:
+					position, tokenIndex = position469, tokenIndex469
+				}
+				add(rulerelation_name, position467)
+			}
+			return true
+		l466:
+			position, tokenIndex = position466, tokenIndex466
+			return false
+		},
+		/* 48 quoted_name <- <('"' string_in_quote '"')> */
+		func() bool {
+			position498, tokenIndex498 := position, tokenIndex
+			{
+				position499 := position
+				if buffer[position] != rune('"') {
+					goto l498
+				}
+				position++
+				if !_rules[rulestring_in_quote]() {
+					goto l498
+				}
+				if buffer[position] != rune('"') {
+					goto l498
+				}
+				position++
+				add(rulequoted_name, position499)
+			}
+			return true
+		l498:
+			position, tokenIndex = position498, tokenIndex498
+			return false
+		},
+		/* 49 index_string <- <(!('"' / '\t' / '\r' / '\n' / '/' / ':' / ',' / '(' / ')' / '[' / ']' / '{' / '}' / ' ') .)+> */
+		func() bool {
+			position500, tokenIndex500 := position, tokenIndex
+			{
+				position501 := position
+				{
+					position504, tokenIndex504 := position, tokenIndex
+					{
+						position505, tokenIndex505 := position, tokenIndex
+						if buffer[position] != rune('"') {
+							goto l506
+						}
+						position++
+						goto l505
+					l506:
+						position, tokenIndex = position505, tokenIndex505
+						if buffer[position] != rune('\t') {
+							goto l507
+						}
+						position++
+						goto l505
+					l507:
+						position, tokenIndex = position505, tokenIndex505
+						if buffer[position] != rune('\r') {
+							goto l508
+						}
+						position++
+						goto l505
+					l508:
+						position, tokenIndex = position505, tokenIndex505
+						if buffer[position] != rune('\n') {
+							goto l509
+						}
+						position++
+						goto l505
+					l509:
+						position, tokenIndex = position505, tokenIndex505
+						if buffer[position] != rune('/') {
+							goto l510
+						}
+						position++
+						goto l505
+					l510:
+						position, tokenIndex = position505, tokenIndex505
+						if buffer[position] != rune(':') {
+							goto l511
+						}
+						position++
+						goto l505
+					l511:
+						position, tokenIndex = position505, tokenIndex505
+						if buffer[position] != rune(',') {
+							goto l512
+						}
+						position++
+						goto l505
+					l512:
+						position, tokenIndex = position505, tokenIndex505
+						if buffer[position] != rune('(') {
+							goto l513
+						}
+						position++
+						goto l505
+					l513:
+						position, tokenIndex = position505, tokenIndex505
+						if buffer[position] != rune(')') {
+							goto l514
+						}
+						position++
+						goto l505
+					l514:
+						position, tokenIndex = position505, tokenIndex505
+						if buffer[position] != rune('[') {
+							goto l515
+						}
+						position++
+						goto l505
+					l515:
+						position, tokenIndex = position505, tokenIndex505
+						if buffer[position] != rune(']') {
+							goto l516
+						}
+						position++
+						goto l505
+					l516:
+						position, tokenIndex = position505, tokenIndex505
+						if buffer[position] != rune('{') {
+							goto l517
+						}
+						position++
+						goto l505
+					l517:
+						position, tokenIndex = position505, tokenIndex505
+						if buffer[position] != rune('}') {
+							goto l518
+						}
+						position++
+						goto l505
+					l518:
+						position, tokenIndex = position505, tokenIndex505
+						if buffer[position] != rune(' ') {
+							goto l504
+						}
+						position++
+					}
+				l505:
+					goto l500
+				l504:
+					position, tokenIndex = position504, tokenIndex504
+				}
+				if !matchDot() {
+					goto l500
+				}
+			l502:
+				{
+					position503, tokenIndex503 := position, tokenIndex
+					{
+						position519, tokenIndex519 := position, tokenIndex
+						{
+							position520, tokenIndex520 := position, tokenIndex
+							if buffer[position] != rune('"') {
+								goto l521
+							}
+							position++
+							goto l520
+						l521:
+							position, tokenIndex = position520, tokenIndex520
+							if buffer[position] != rune('\t') {
+								goto l522
+							}
+							position++
+							goto l520
+						l522:
+							position, tokenIndex = position520, tokenIndex520
+							if buffer[position] != rune('\r') {
+								goto l523
+							}
+							position++
+							goto l520
+						l523:
+							position, tokenIndex = position520, tokenIndex520
+							if buffer[position] != rune('\n') {
+								goto l524
+							}
+							position++
+							goto l520
+						l524:
+							position, tokenIndex = position520, tokenIndex520
+							if buffer[position] != rune('/') {
+								goto l525
+							}
+							position++
+							goto l520
+						l525:
+							position, tokenIndex = position520, tokenIndex520
+							if buffer[position] != rune(':') {
+								goto l526
+							}
+							position++
+							goto l520
+						l526:
+							position, tokenIndex = position520, tokenIndex520
+							if buffer[position] != rune(',') {
+								goto l527
+							}
+							position++
+							goto l520
+						l527:
+							position, tokenIndex = position520, tokenIndex520
+							if buffer[position] != rune('(') {
+								goto l528
+							}
+							position++
+							goto l520
+						l528:
+							position, tokenIndex = position520, tokenIndex520
+							if buffer[position] != rune(')') {
+								goto l529
+							}
+							position++
+							goto l520
+						l529:
+							position, tokenIndex = position520, tokenIndex520
+							if buffer[position] != rune('[') {
+								goto l530
+							}
+							position++
+							goto l520
+						l530:
+							position, tokenIndex = position520, tokenIndex520
+							if buffer[position] != rune(']') {
+								goto l531
+							}
+							position++
+							goto l520
+						l531:
+							position, tokenIndex = position520, tokenIndex520
+							if buffer[position] != rune('{') {
+								goto l532
+							}
+							position++
+							goto l520
+						l532:
+							position, tokenIndex = position520, tokenIndex520
+							if buffer[position] != rune('}') {
+								goto l533
+							}
+							position++
+							goto l520
+						l533:
+							position, tokenIndex = position520, tokenIndex520
+							if buffer[position] != rune(' ') {
+								goto l519
+							}
+							position++
+						}
+					l520:
+						goto l503
+					l519:
+						position, tokenIndex = position519, tokenIndex519
+					}
+					if !matchDot() {
+						goto l503
+					}
+					goto l502
+				l503:
+					position, tokenIndex = position503, tokenIndex503
+				}
+				add(ruleindex_string, position501)
+			}
+			return true
+		l500:
+			position, tokenIndex = position500, tokenIndex500
+			return false
+		},
+		/* 50 type_string <- <(!('"' / '\t' / '\r' / '\n' / '/' / ':' / ',' / '(' / ')' / '[' / ']' / '{' / '}' / ' ') .)+> */
+		func() bool {
+			position534, tokenIndex534 := position, tokenIndex
+			{
+				position535 := position
+				{
+					position538, tokenIndex538 := position, tokenIndex
+					{
+						position539, tokenIndex539 := position, tokenIndex
+						if buffer[position] != rune('"') {
+							goto l540
+						}
+						position++
+						goto l539
+					l540:
+						position, tokenIndex = position539, tokenIndex539
+						if buffer[position] != rune('\t') {
+							goto l541
+						}
+						position++
+						goto l539
+					l541:
+						position, tokenIndex = position539, tokenIndex539
+						if buffer[position] != rune('\r') {
+							goto l542
+						}
+						position++
+						goto l539
+					l542:
+						position, tokenIndex = position539, tokenIndex539
+						if buffer[position] != rune('\n') {
+							goto l543
+						}
+						position++
+						goto l539
+					l543:
+						position, tokenIndex = position539, tokenIndex539
+						if buffer[position] != rune('/') {
+							goto l544
+						}
+						position++
+						goto l539
+					l544:
+						position, tokenIndex = position539, tokenIndex539
+						if buffer[position] != rune(':') {
+							goto l545
+						}
+						position++
+						goto l539
+					l545:
+						position, tokenIndex = position539, tokenIndex539
+						if buffer[position] != rune(',') {
+							goto l546
+						}
+						position++
+						goto l539
+					l546:
+						position, tokenIndex = position539, tokenIndex539
+						if buffer[position] != rune('(') {
+							goto l547
+						}
+						position++
+						goto l539
+					l547:
+						position, tokenIndex = position539, tokenIndex539
+						if buffer[position] != rune(')') {
+							goto l548
+						}
+						position++
+						goto l539
+					l548:
+						position, tokenIndex = position539, tokenIndex539
+						if buffer[position] != rune('[') {
+							goto l549
+						}
+						position++
+						goto l539
+					l549:
+						position, tokenIndex = position539, tokenIndex539
+						if buffer[position] != rune(']') {
+							goto l550
+						}
+						position++
+						goto l539
+					l550:
+						position, tokenIndex = position539, tokenIndex539
+						if buffer[position] != rune('{') {
+							goto l551
+						}
+						position++
+						goto l539
+					l551:
+						position, tokenIndex = position539, tokenIndex539
+						if buffer[position] != rune('}') {
+							goto l552
+						}
+						position++
+						goto l539
+					l552:
+						position, tokenIndex = position539, tokenIndex539
+						if buffer[position] != rune(' ') {
+							goto l538
+						}
+						position++
+					}
+				l539:
+					goto l534
+				l538:
+					position, tokenIndex = position538, tokenIndex538
+				}
+				if !matchDot() {
+					goto l534
+				}
+			l536:
+				{
+					position537, tokenIndex537 := position, tokenIndex
+					{
+						position553, tokenIndex553 := position, tokenIndex
+						{
+							position554, tokenIndex554 := position, tokenIndex
+							if buffer[position] != rune('"') {
+								goto l555
+							}
+							position++
+							goto l554
+						l555:
+							position, tokenIndex = position554, tokenIndex554
+							if buffer[position] != rune('\t') {
+								goto l556
+							}
+							position++
+							goto l554
+						l556:
+							position, tokenIndex = position554, tokenIndex554
+							if buffer[position] != rune('\r') {
+								goto l557
+							}
+							position++
+							goto l554
+						l557:
+							position, tokenIndex = position554, tokenIndex554
+							if buffer[position] != rune('\n') {
+								goto l558
+							}
+							position++
+							goto l554
+						l558:
+							position, tokenIndex = position554, tokenIndex554
+							if buffer[position] != rune('/') {
+								goto l559
+							}
+							position++
+							goto l554
+						l559:
+							position, tokenIndex = position554, tokenIndex554
+							if buffer[position] != rune(':') {
+								goto l560
+							}
+							position++
+							goto l554
+						l560:
+							position, tokenIndex = position554, tokenIndex554
+							if buffer[position] != rune(',') {
+								goto l561
+							}
+							position++
+							goto l554
+						l561:
+							position, tokenIndex = position554, tokenIndex554
+							if buffer[position] != rune('(') {
+								goto l562
+							}
+							position++
+							goto l554
+						l562:
+							position, tokenIndex = position554, tokenIndex554
+							if buffer[position] != rune(')') {
+								goto l563
+							}
+							position++
+							goto l554
+						l563:
+							position, tokenIndex = position554, tokenIndex554
+							if buffer[position] != rune('[') {
+								goto l564
+							}
+							position++
+							goto l554
+						l564:
+							position, tokenIndex = position554, tokenIndex554
+							if buffer[position] != rune(']') {
+								goto l565
+							}
+							position++
+							goto l554
+						l565:
+							position, tokenIndex = position554, tokenIndex554
+							if buffer[position] != rune('{') {
+								goto l566
+							}
+							position++
+							goto l554
+						l566:
+							position, tokenIndex = position554, tokenIndex554
+							if buffer[position] != rune('}') {
+								goto l567
+							}
+							position++
+							goto l554
+						l567:
+							position, tokenIndex = position554, tokenIndex554
+							if buffer[position] != rune(' ') {
+								goto l553
+							}
+							position++
+						}
+					l554:
+						goto l537
+					l553:
+						position, tokenIndex = position553, tokenIndex553
+					}
+					if !matchDot() {
+						goto l537
+					}
+					goto l536
+				l537:
+					position, tokenIndex = position537, tokenIndex537
+				}
+				add(ruletype_string, position535)
+			}
+			return true
+		l534:
+			position, tokenIndex = position534, tokenIndex534
+			return false
+		},
+		/* 51 cardinality <- <('0' / '1' / '*' / '+')> */
+		func() bool {
+			position568, tokenIndex568 := position, tokenIndex
+			{
+				position569 := position
+				{
+					position570, tokenIndex570 := position, tokenIndex
+					if buffer[position] != rune('0') {
+						goto l571
+					}
+					position++
+					goto l570
+				l571:
+					position, tokenIndex = position570, tokenIndex570
+					if buffer[position] != rune('1') {
+						goto l572
+					}
+					position++
+					goto l570
+				l572:
+					position, tokenIndex = position570, tokenIndex570
+					if buffer[position] != rune('*') {
+						goto l573
+					}
+					position++
+					goto l570
+				l573:
+					position, tokenIndex = position570, tokenIndex570
+					if buffer[position] != rune('+') {
+						goto l568
+					}
+					position++
+				}
+			l570:
+				add(rulecardinality, position569)
+			}
+			return true
+		l568:
+			position, tokenIndex = position568, tokenIndex568
+			return false
+		},
+		nil,
+		/* 54 Action0 <- <{ p.SkipTable(begin) }> */
+		func() bool {
+			{
+				add(ruleAction0, position)
+			}
+			return true
+		},
+		/* 55 Action1 <- <{ p.Err(begin, buffer) }> */
+		func() bool {
+			{
+				add(ruleAction1, position)
+			}
+			return true
+		},
+		/* 56 Action2 <- <{ p.ClearTableAndColumn() }> */
+		func() bool {
+			{
+				add(ruleAction2, position)
+			}
+			return true
+		},
+		/* 57 Action3 <- <{ p.AddComment(text, begin) }> */
+		func() bool {
+			{
+				add(ruleAction3, position)
+			}
+			return true
+		},
+		/* 58 Action4 <- <{ p.SetTitlePos(begin) }> */
+		func() bool {
+			{
+				add(ruleAction4, position)
+			}
+			return true
+		},
+		/* 59 Action5 <- <{ p.AddGroup(text, begin) }> */
+		func() bool {
+			{
+				add(ruleAction5, position)
+			}
+			return true
+		},
+		/* 60 Action6 <- <{ p.AddInclude(text, begin) }> */
+		func() bool {
+			{
+				add(ruleAction6, position)
+			}
+			return true
+		},
+		/* 61 Action7 <- <{ p.AddTable(text, begin) }> */
+		func() bool {
+			{
+				add(ruleAction7, position)
+			}
+			return true
+		},
+		/* 62 Action8 <- <{ p.AddColumn(text, begin) }> */
+		func() bool {
+			{
+				add(ruleAction8, position)
+			}
+			return true
+		},
+		/* 63 Action9 <- <{ p.SetPrimaryKey() }> */
+		func() bool {
+			{
+				add(ruleAction9, position)
+			}
+			return true
+		},
+		/* 64 Action10 <- <{ p.SetForeignKey() }> */
+		func() bool {
+			{
+				add(ruleAction10, position)
+			}
+			return true
+		},
+		/* 65 Action11 <- <{ p.SetColumnType(text) }> */
+		func() bool {
+			{
+				add(ruleAction11, position)
+			}
+			return true
+		},
+		/* 66 Action12 <- <{ p.SetColumnNotNull(true) }> */
+		func() bool {
+			{
+				add(ruleAction12, position)
+			}
+			return true
+		},
+		/* 67 Action13 <- <{ p.SetColumnNotNull(false) }> */
+		func() bool {
+			{
+				add(ruleAction13, position)
+			}
+			return true
+		},
+		/* 68 Action14 <- <{ p.SetColumnDefault(text) }> */
+		func() bool {
+			{
+				add(ruleAction14, position)
+			}
+			return true
+		},
+		/* 69 Action15 <- <{ p.AddIndex(text, begin) }> */
+		func() bool {
+			{
+				add(ruleAction15, position)
+			}
+			return true
+		},
+		/* 70 Action16 <- <{ p.AddIndexColumn(text, begin) }> */
+		func() bool {
+			{
+				add(ruleAction16, position)
+			}
+			return true
+		},
+		/* 71 Action17 <- <{ p.AddRelation() }> */
+		func() bool {
+			{
+				add(ruleAction17, position)
+			}
+			return true
+		},
+		/* 72 Action18 <- <{ p.SetRelationLeft(p.unquote(text, begin), begin) }> */
+		func() bool {
+			{
+				add(ruleAction18, position)
+			}
+			return true
+		},
+		/* 73 Action19 <- <{ p.SetRelationLeft(text, begin) }> */
+		func() bool {
+			{
+				add(ruleAction19, position)
+			}
+			return true
+		},
+		/* 74 Action20 <- <{ p.SetRelationLeftColumn(p.unquote(text, begin)) }> */
+		func() bool {
+			{
+				add(ruleAction20, position)
+			}
+			return true
+		},
+		/* 75 Action21 <- <{ p.SetRelationLeftColumn(text) }> */
+		func() bool {
+			{
+				add(ruleAction21, position)
+			}
+			return true
+		},
+		/* 76 Action22 <- <{ p.SetCardinalityLeft(text)}> */
+		func() bool {
+			{
+				add(ruleAction22, position)
+			}
+			return true
+		},
+		/* 77 Action23 <- <{ p.SetRelationRight(p.unquote(text, begin), begin) }> */
+		func() bool {
+			{
+				add(ruleAction23, position)
+			}
+			return true
+		},
+		/* 78 Action24 <- <{ p.SetRelationRight(text, begin) }> */
+		func() bool {
+			{
+				add(ruleAction24, position)
+			}
+			return true
+		},
+		/* 79 Action25 <- <{ p.SetRelationRightColumn(p.unquote(text, begin)) }> */
+		func() bool {
+			{
+				add(ruleAction25, position)
+			}
+			return true
+		},
+		/* 80 Action26 <- <{ p.SetRelationRightColumn(text) }> */
+		func() bool {
+			{
+				add(ruleAction26, position)
+			}
+			return true
+		},
+		/* 81 Action27 <- <{ p.SetCardinalityRight(text)}> */
+		func() bool {
+			{
+				add(ruleAction27, position)
+			}
+			return true
+		},
+		/* 82 Action28 <- <{ p.AddTitleKeyValue() }> */
+		func() bool {
+			{
+				add(ruleAction28, position)
+			}
+			return true
+		},
+		/* 83 Action29 <- <{ p.AddGroupKeyValue() }> */
+		func() bool {
+			{
+				add(ruleAction29, position)
+			}
+			return true
+		},
+		/* 84 Action30 <- <{ p.AddTableKeyValue() }> */
+		func() bool {
+			{
+				add(ruleAction30, position)
+			}
+			return true
+		},
+		/* 85 Action31 <- <{ p.AddColumnKeyValue() }> */
+		func() bool {
+			{
+				add(ruleAction31, position)
+			}
+			return true
+		},
+		/* 86 Action32 <- <{ p.AddRelationKeyValue() }> */
+		func() bool {
+			{
+				add(ruleAction32, position)
+			}
+			return true
+		},
+		/* 87 Action33 <- <{ p.AddIndexKeyValue() }> */
+		func() bool {
+			{
+				add(ruleAction33, position)
+			}
+			return true
+		},
+		/* 88 Action34 <- <{ p.SetKey(text, begin) }> */
+		func() bool {
+			{
+				add(ruleAction34, position)
+			}
+			return true
+		},
+		/* 89 Action35 <- <{ p.SetValue(text, begin) }> */
+		func() bool {
+			{
+				add(ruleAction35, position)
+			}
+			return true
+		},
+		/* 90 Action36 <- <{ p.SetValue(text, begin) }> */
+		func() bool {
+			{
+				add(ruleAction36, position)
+			}
+			return true
+		},
+	}
+	p.rules = _rules
+}
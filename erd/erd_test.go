@@ -0,0 +1,33 @@
+package erd
+
+import (
+	"strings"
+	"testing"
+)
+
+func TestParse(t *testing.T) {
+	e, err := Parse(strings.NewReader("[Person]\n*name\n[Location]\n*id\nPerson *--1 Location\n"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(e.Tables) != 2 || len(e.Relations) != 1 {
+		t.Errorf("got: %v tables, %v relations\nwant: 2 tables, 1 relation", len(e.Tables), len(e.Relations))
+	}
+}
+
+func TestParse_errors(t *testing.T) {
+	e, err := Parse(strings.NewReader("[Person]\n*name\nPerson *--1 Location\n!!!\n"))
+	errs, ok := err.(ErrorList)
+	if !ok {
+		t.Fatalf("got: %#v\nwant: ErrorList", err)
+	}
+	if len(errs) != 2 {
+		t.Errorf("got: %v errors\nwant: 2", len(errs))
+	}
+	if want := `<input>:3:13: error: relation references undefined table "Location" (and 1 more errors)`; err.Error() != want {
+		t.Errorf("got: %v\nwant: %v", err, want)
+	}
+	if e == nil || e.Table("Person") == nil {
+		t.Error("the model of the valid part of the schema is missing")
+	}
+}
@@ -0,0 +1,244 @@
+package erd
+
+import (
+	"fmt"
+	"io/ioutil"
+	"path/filepath"
+	"sort"
+	"strings"
+)
+
+// Load is like ParseString but also reads the files named by the include
+// lines of the schema, and the files they include in turn. Paths are
+// relative to the directory of the including file, that of name for the
+// schema itself, and may be globs. A file is read once even if it is
+// included several times; including a file from itself, directly or not,
+// is an error.
+//
+// The included files are parsed as if their text followed the include
+// lines, so relations may reference the tables of any file, but a table
+// ends with the file that declares it. Diagnostics
+// carry the file and line they come from. Includes of the returned model
+// is empty since the included files are part of it.
+func Load(name string, buffer string) (*Erd, error) {
+	return LoadFiles([]File{{Name: name, Text: buffer}})
+}
+
+// File is a schema given to LoadFiles: its file name and its contents.
+type File struct {
+	Name string
+	Text string
+}
+
+// LoadFiles is like Load for a schema made of several files, parsed in
+// order as if they were one, except that a table ends with its file. Tables
+// declared in more than one file are reported, and files given twice are
+// read once.
+func LoadFiles(files []File) (*Erd, error) {
+	if len(files) == 0 {
+		return nil, fmt.Errorf("no schema files")
+	}
+	l := &loader{done: map[string]bool{}}
+	for _, f := range files {
+		if l.done[absPath(f.Name)] {
+			continue
+		}
+		l.add(f.Name, []rune(f.Text), nil)
+		if len(l.buf) > 0 && l.buf[len(l.buf)-1] != '\n' {
+			l.buf = append(l.buf, '\n')
+		}
+	}
+	return parse(files[0].Name, string(l.buf), l.sources, func(e *Erd) {
+		for _, f := range l.failures {
+			e.errorf(f.begin, f.end, "%s", f.message)
+		}
+		e.Includes = nil
+	})
+}
+
+// source is a span of the buffer built by Load that was copied from a file.
+type source struct {
+	begin, end int    // rune offsets of the span in the buffer
+	file       string // name of the file
+	text       []rune // contents of the file
+	offset     int    // rune offset of the span in text
+}
+
+// failure is an include line that could not be followed, at rune offsets
+// of the buffer.
+type failure struct {
+	begin, end int
+	message    string
+}
+
+// loader builds the buffer parsed by Load.
+type loader struct {
+	buf      []rune
+	sources  []source
+	failures []failure
+	done     map[string]bool
+}
+
+// add appends text, the contents of file, to the buffer, each include line
+// followed by the files it names. stack holds the files including file.
+func (l *loader) add(file string, text []rune, stack []string) {
+	l.done[absPath(file)] = true
+	stack = append(stack, file)
+
+	offset := 0
+	for _, inc := range includes(text) {
+		end := inc.Pos
+		for end < len(text) && text[end] != '\n' {
+			end++
+		}
+		if end < len(text) {
+			end++
+		}
+		pathEnd := inc.Pos + 1
+		for pathEnd < len(text) && text[pathEnd] != '"' {
+			pathEnd++
+		}
+		pathEnd++
+		l.copy(file, text, offset, end)
+		if len(l.buf) > 0 && l.buf[len(l.buf)-1] != '\n' {
+			l.buf = append(l.buf, '\n')
+		}
+		offset = end
+		// The include line is kept, so that its position is known.
+		begin := l.sources[len(l.sources)-1].begin + inc.Pos - l.sources[len(l.sources)-1].offset
+		fail := func(format string, a ...interface{}) {
+			l.failures = append(l.failures, failure{begin, begin + pathEnd - inc.Pos, fmt.Sprintf(format, a...)})
+		}
+
+		files, err := resolveInclude(file, inc.Path)
+		if err != nil {
+			fail("%v", err)
+			continue
+		}
+		for _, f := range files {
+			if i := indexOf(stack, f); i >= 0 {
+				// A glob may match the files including it, which
+				// is not worth an error.
+				if !hasMeta(inc.Path) {
+					fail("include cycle: %s", strings.Join(append(stack[i:], f), " includes "))
+				}
+				continue
+			}
+			if l.done[absPath(f)] {
+				continue
+			}
+			contents, err := ioutil.ReadFile(f)
+			if err != nil {
+				fail("cannot include %q: %v", inc.Path, err)
+				continue
+			}
+			l.add(f, []rune(string(contents)), stack)
+			if len(l.buf) > 0 && l.buf[len(l.buf)-1] != '\n' {
+				l.buf = append(l.buf, '\n')
+			}
+		}
+	}
+	l.copy(file, text, offset, len(text))
+}
+
+// includes returns the include lines of text. They are found by the
+// parser, so that they are only recognized where the grammar allows them.
+func includes(text []rune) (list []Include) {
+	defer func() {
+		// The parse of the whole buffer reports the problem.
+		if recover() != nil {
+			list = nil
+		}
+	}()
+	parser := &Parser{Buffer: string(text)}
+	parser.Init()
+	if err := parser.Parse(); err != nil {
+		return nil
+	}
+	parser.Execute()
+	return parser.Erd.Includes
+}
+
+// copy appends text[from:to], from file, to the buffer.
+func (l *loader) copy(file string, text []rune, from, to int) {
+	if from >= to {
+		return
+	}
+	l.sources = append(l.sources, source{
+		begin:  len(l.buf),
+		end:    len(l.buf) + to - from,
+		file:   file,
+		text:   text,
+		offset: from,
+	})
+	l.buf = append(l.buf, text[from:to]...)
+}
+
+// resolveInclude returns the files named by path in an include line of
+// file.
+func resolveInclude(file, path string) ([]string, error) {
+	path = filepath.FromSlash(path)
+	if !filepath.IsAbs(path) {
+		path = filepath.Join(filepath.Dir(file), path)
+	}
+	if !hasMeta(path) {
+		return []string{path}, nil
+	}
+	files, err := filepath.Glob(path)
+	if err != nil {
+		return nil, fmt.Errorf("invalid include pattern %q: %v", path, err)
+	}
+	if len(files) == 0 {
+		return nil, fmt.Errorf("include pattern %q matches no files", path)
+	}
+	return files, nil
+}
+
+func hasMeta(path string) bool {
+	return strings.ContainsAny(path, `*?[`)
+}
+
+func absPath(file string) string {
+	if abs, err := filepath.Abs(file); err == nil {
+		return abs
+	}
+	return file
+}
+
+// indexOf returns the index of the first file of list that is file, or -1.
+func indexOf(list []string, file string) int {
+	abs := absPath(file)
+	for i, f := range list {
+		if absPath(f) == abs {
+			return i
+		}
+	}
+	return -1
+}
+
+// locate returns the file, contents and offset in the contents of the rune
+// offset pos of the buffer built by Load.
+func (e *Erd) locate(pos int) (string, []rune, int) {
+	s := e.sources[e.sourceIndex(pos)]
+	if pos > s.end {
+		pos = s.end
+	}
+	return s.file, s.text, s.offset + pos - s.begin
+}
+
+// sourceIndex returns the index in sources of the span holding the rune
+// offset pos of the buffer built by Load, or 0 if there are no spans.
+func (e *Erd) sourceIndex(pos int) int {
+	i := sort.Search(len(e.sources), func(i int) bool { return e.sources[i].begin > pos }) - 1
+	if i < 0 {
+		i = 0
+	}
+	return i
+}
+
+// sameSource reports whether the rune offsets a and b of the buffer were
+// copied from the same part of a file, with no other file spliced between
+// them.
+func (e *Erd) sameSource(a, b int) bool {
+	return e.sourceIndex(a) == e.sourceIndex(b)
+}
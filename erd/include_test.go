@@ -0,0 +1,227 @@
+package erd
+
+import (
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"reflect"
+	"strings"
+	"testing"
+)
+
+// writeFiles writes files, relative paths mapped to their contents, into a
+// new temporary directory and returns it.
+func writeFiles(t *testing.T, files map[string]string) string {
+	dir, err := ioutil.TempDir("", "erd-include")
+	if err != nil {
+		t.Fatal(err)
+	}
+	for name, src := range files {
+		path := filepath.Join(dir, filepath.FromSlash(name))
+		if err := os.MkdirAll(filepath.Dir(path), 0755); err != nil {
+			t.Fatal(err)
+		}
+		if err := ioutil.WriteFile(path, []byte(src), 0644); err != nil {
+			t.Fatal(err)
+		}
+	}
+	return dir
+}
+
+// load loads the file name of dir and returns the model and its
+// diagnostics, with dir left out of the file names.
+func load(t *testing.T, dir, name string) (*Erd, []string) {
+	path := filepath.Join(dir, name)
+	src, err := ioutil.ReadFile(path)
+	if err != nil {
+		t.Fatal(err)
+	}
+	e, _ := Load(path, string(src))
+	if e == nil {
+		t.Fatal("no model")
+	}
+	var diags []string
+	for _, d := range e.Diagnostics {
+		diags = append(diags, strings.Replace(filepath.ToSlash(d.Error()), filepath.ToSlash(dir)+"/", "", -1))
+	}
+	return e, diags
+}
+
+func TestLoad(t *testing.T) {
+	dir := writeFiles(t, map[string]string{
+		"main.er": `title {label: Shop}
+include "billing.er"
+include "teams/*.er"
+
+[customer]
+*id
+
+invoice *--1 customer
+player *--1 team`,
+		"billing.er": `[invoice]
+*id
++customer_id`,
+		"teams/players.er": `include "../billing.er"
+include "../shared/team.er"
+
+[player]
+*id
+`,
+		"teams/staff.er": `[staff]
+*id
+staff *--1 team
+`,
+		"shared/team.er": `[team]
+*id
+`,
+	})
+	defer os.RemoveAll(dir)
+
+	e, diags := load(t, dir, "main.er")
+	if len(diags) > 0 {
+		t.Fatalf("unexpected diagnostics: %q", diags)
+	}
+	var tables []string
+	for _, table := range e.Tables {
+		tables = append(tables, table.Title)
+	}
+	if want := []string{"invoice", "team", "player", "staff", "customer"}; !reflect.DeepEqual(tables, want) {
+		t.Errorf("tables %v, want %v", tables, want)
+	}
+	if len(e.Relations) != 3 {
+		t.Errorf("%d relations, want 3", len(e.Relations))
+	}
+	if e.Title.TitleAttributes["label"] != "Shop" || len(e.Includes) != 0 {
+		t.Errorf("title %v, includes %v", e.Title.TitleAttributes, e.Includes)
+	}
+}
+
+func TestLoad_diagnostics(t *testing.T) {
+	dir := writeFiles(t, map[string]string{
+		"main.er": `include "a.er"
+include "missing.er"
+include "none/*.er"
+
+[main]
+*id
+main *--1 nope
+`,
+		"a.er": `include "b.er"
+
+[a]
+*id
+[broken
+`,
+		"b.er": `# b includes a back
+include "a.er"
+
+[main]
+*id
+b *--1 a`,
+	})
+	defer os.RemoveAll(dir)
+
+	_, diags := load(t, dir, "main.er")
+	want := []string{
+		`b.er:2:9: error: include cycle: a.er includes b.er includes a.er`,
+		`b.er:6:1: error: relation references undefined table "b"`,
+		`a.er:5:1: error: syntax error`,
+		`main.er:2:9: error: cannot include "missing.er": open missing.er: no such file or directory`,
+		`main.er:3:9: error: include pattern "none/*.er" matches no files`,
+		`main.er:5:2: error: table "main" is already declared in b.er`,
+		`main.er:7:11: error: relation references undefined table "nope"`,
+	}
+	if !reflect.DeepEqual(diags, want) {
+		t.Errorf("got:\n%s\nwant:\n%s", strings.Join(diags, "\n"), strings.Join(want, "\n"))
+	}
+}
+
+func TestLoad_tableEndsWithFile(t *testing.T) {
+	dir := writeFiles(t, map[string]string{
+		"main.er": `[A]
+*id
+include "sub/b.er"
+extra
+`,
+		"sub/b.er": `[B]
+*id
+index b_id (id)`,
+	})
+	defer os.RemoveAll(dir)
+
+	e, diags := load(t, dir, "main.er")
+	want := []string{`main.er:4:1: error: column "extra" is declared outside of a table`}
+	if !reflect.DeepEqual(diags, want) {
+		t.Errorf("got %q, want %q", diags, want)
+	}
+	if b := e.Table("B"); b == nil || len(b.Columns) != 1 || len(b.Indexes) != 1 {
+		t.Errorf("B is %+v, want its id column and index only", b)
+	}
+}
+
+func TestLoadFiles(t *testing.T) {
+	dir := writeFiles(t, map[string]string{
+		"shared.er": "[team]\n*id",
+		"players.er": `include "shared.er"
+
+[player]
+*id
+player *--1 team
+`,
+	})
+	defer os.RemoveAll(dir)
+
+	var files []File
+	for _, name := range []string{"players.er", "shared.er"} {
+		path := filepath.Join(dir, name)
+		src, err := ioutil.ReadFile(path)
+		if err != nil {
+			t.Fatal(err)
+		}
+		files = append(files, File{Name: path, Text: string(src)})
+	}
+	files = append(files, File{Name: "<stdin>", Text: "[game]\n*id\n[team]\n*id\ngame *--1 team\n"})
+
+	e, err := LoadFiles(files)
+	if e == nil {
+		t.Fatal(err)
+	}
+	var tables []string
+	for _, table := range e.Tables {
+		tables = append(tables, table.Title)
+	}
+	if want := []string{"team", "player", "game"}; !reflect.DeepEqual(tables, want) {
+		t.Errorf("tables %v, want %v", tables, want)
+	}
+	if len(e.Relations) != 2 {
+		t.Errorf("%d relations, want 2", len(e.Relations))
+	}
+	want := []string{`<stdin>:3:2: error: table "team" is already declared in ` + filepath.Join(dir, "shared.er")}
+	var got []string
+	for _, d := range e.Diagnostics {
+		got = append(got, d.Error())
+	}
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("got %q, want %q", got, want)
+	}
+
+	// A table ends with the file declaring it.
+	e, err = LoadFiles([]File{
+		{Name: "a.er", Text: "[a]\n*id"},
+		{Name: "b.er", Text: "extra\n[b]\n*id\n"},
+	})
+	got = nil
+	for _, d := range e.Diagnostics {
+		got = append(got, d.Error())
+	}
+	if want := []string{`b.er:1:1: error: column "extra" is declared outside of a table`}; !reflect.DeepEqual(got, want) {
+		t.Errorf("got %q, want %q", got, want)
+	}
+	if a := e.Table("a"); len(a.Columns) != 1 {
+		t.Errorf("a has columns %+v", a.Columns)
+	}
+
+	if _, err := LoadFiles(nil); err == nil {
+		t.Error("no error without files")
+	}
+}
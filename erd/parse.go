@@ -0,0 +1,471 @@
+package erd
+
+import (
+	"strconv"
+	"strings"
+)
+
+type Relation struct {
+	LeftTableName      string
+	LeftColumn         string
+	LeftCardinality    string
+	RightTableName     string
+	RightColumn        string
+	RightCardinality   string
+	RelationAttributes map[string]string
+	LeftPos            int
+	RightPos           int
+}
+
+type Index struct {
+	Title           string
+	Columns         []string
+	IsUnique        bool
+	IndexAttributes map[string]string
+	Pos             int
+	columnPos       []int
+}
+
+type Column struct {
+	Title            string
+	Type             string
+	IsNotNull        bool
+	Default          string
+	IsPrimaryKey     bool
+	IsForeignKey     bool
+	ColumnAttributes map[string]string
+	Pos              int
+}
+
+type Table struct {
+	Title           string
+	TableAttributes map[string]string
+	Columns         []Column
+	CurrentColumnId int
+	PrimaryKeys     []int
+	Indexes         []Index
+	Pos             int
+	// Stub marks a table that only shows where a focused diagram connects
+	// to the rest of the schema; its columns and indexes are left out.
+	Stub bool
+}
+
+func (t *Table) HasColumnTypes() bool {
+	for _, c := range t.Columns {
+		if c.Type != "" {
+			return true
+		}
+	}
+	return false
+}
+
+func (t *Table) HasColumnConstraints() bool {
+	for _, c := range t.Columns {
+		if c.IsNotNull || c.Default != "" {
+			return true
+		}
+	}
+	return false
+}
+
+func (t *Table) HasColumn(title string) bool {
+	for _, c := range t.Columns {
+		if c.Title == title {
+			return true
+		}
+	}
+	return false
+}
+
+type Title struct {
+	Title           string
+	TitleAttributes map[string]string
+	Pos             int
+}
+
+// Group is a named set of tables, such as a subject area of a large schema.
+// A table belongs to the group named by its group attribute. A group line
+// gives a group its attributes; Validate adds the groups that tables name
+// without such a line, with Pos -1, and fills in Tables.
+type Group struct {
+	Title           string
+	GroupAttributes map[string]string
+	Tables          []*Table
+	Pos             int
+}
+
+// Include is an include line, naming a file or a glob of files whose
+// tables and relations are part of the schema. Load reads them; ParseString
+// only records the line.
+type Include struct {
+	Path string
+	Pos  int
+}
+
+// Comment is a "#" comment line of the source. Text holds the comment from
+// its '#' on and BlankAfter records whether a blank line follows it, so that
+// printers can keep comments next to what they describe.
+type Comment struct {
+	Text       string
+	Pos        int
+	BlankAfter bool
+}
+
+type Erd struct {
+	Title            Title
+	Tables           []*Table
+	tableIndex       map[string]*Table
+	Relations        []Relation
+	Groups           []*Group
+	Includes         []Include
+	groupIndex       map[string]*Group
+	duplicateGroups  []*Group
+	currentGroup     *Group
+	CurrentRelation  Relation
+	key              string
+	value            string
+	CurrentTableName string
+	IsError          bool
+	line             int
+	isPrimaryKey     bool
+	isForeignKey     bool
+	currentTable     *Table
+	duplicateTables  []*Table
+	valuePos         int
+	Diagnostics      []*Diagnostic
+	Comments         []Comment
+	sources          []source
+}
+
+// Table returns the table declared with the given title, or nil if there is
+// none.
+func (e *Erd) Table(title string) *Table {
+	return e.tableIndex[title]
+}
+
+// Group returns the group with the given title, or nil if there is none.
+func (e *Erd) Group(title string) *Group {
+	return e.groupIndex[title]
+}
+
+// Retain drops the tables for which keep returns false, along with the
+// relations that reference them and the groups left without tables.
+func (e *Erd) Retain(keep func(t *Table) bool) {
+	var tables []*Table
+	e.tableIndex = map[string]*Table{}
+	for _, t := range e.Tables {
+		if keep(t) {
+			tables = append(tables, t)
+			e.tableIndex[t.Title] = t
+		}
+	}
+	e.Tables = tables
+
+	var relations []Relation
+	for _, r := range e.Relations {
+		if e.Table(r.LeftTableName) != nil && e.Table(r.RightTableName) != nil {
+			relations = append(relations, r)
+		}
+	}
+	e.Relations = relations
+
+	var groups []*Group
+	e.groupIndex = map[string]*Group{}
+	for _, g := range e.Groups {
+		var kept []*Table
+		for _, t := range g.Tables {
+			if e.Table(t.Title) == t {
+				kept = append(kept, t)
+			}
+		}
+		if len(kept) > 0 {
+			g.Tables = kept
+			groups = append(groups, g)
+			e.groupIndex[g.Title] = g
+		}
+	}
+	e.Groups = groups
+}
+
+// Subset returns a copy of e left as Retain(keep) would leave it, without
+// changing e. The tables are shared by e and the copy.
+func (e *Erd) Subset(keep func(t *Table) bool) *Erd {
+	s := *e
+	s.Groups = make([]*Group, len(e.Groups))
+	for i, g := range e.Groups {
+		c := *g
+		s.Groups[i] = &c
+	}
+	s.Retain(keep)
+	return &s
+}
+
+func (e *Erd) addTableTitle(t string) {
+	t = strings.Trim(t, "\"")
+	e.currentTable.Title = t
+}
+
+func (e *Erd) ClearTableAndColumn() {
+	e.CurrentTableName = ""
+	e.currentTable = nil
+}
+
+// SkipTable directs the columns following a syntax error at pos into a
+// table that isn't part of the model, so they are neither lost in errors nor
+// attached to the wrong table.
+func (e *Erd) SkipTable(pos int) {
+	e.CurrentTableName = ""
+	e.currentTable = &Table{Pos: pos}
+}
+
+// closeTableAt ends the current table if pos is in another file than its
+// header, since a table doesn't extend past the end of its file.
+func (e *Erd) closeTableAt(pos int) {
+	if e.currentTable != nil && !e.sameSource(e.currentTable.Pos, pos) {
+		e.ClearTableAndColumn()
+	}
+}
+
+func (e *Erd) SetTitlePos(pos int) {
+	e.Title.Pos = pos
+}
+
+func (e *Erd) AddComment(text string, pos int) {
+	e.Comments = append(e.Comments, Comment{Text: strings.TrimRight(text, " \t"), Pos: pos})
+}
+
+func (e *Erd) AddTitleKeyValue() {
+	if e.Title.TitleAttributes == nil {
+		e.Title.TitleAttributes = map[string]string{}
+	}
+	e.Title.TitleAttributes[e.key] = e.value
+}
+
+// AddGroup declares a group. text is its name quoted as in the source.
+func (e *Erd) AddGroup(text string, pos int) {
+	if e.groupIndex == nil {
+		e.groupIndex = map[string]*Group{}
+	}
+	text = e.unquote(text, pos)
+	group := &Group{Title: text, GroupAttributes: map[string]string{}, Pos: pos}
+	if _, ok := e.groupIndex[text]; ok {
+		e.duplicateGroups = append(e.duplicateGroups, group)
+	} else {
+		e.Groups = append(e.Groups, group)
+		e.groupIndex[text] = group
+	}
+	e.currentGroup = group
+	e.ClearTableAndColumn()
+}
+
+func (e *Erd) AddInclude(text string, pos int) {
+	e.ClearTableAndColumn()
+	e.Includes = append(e.Includes, Include{Path: e.unquote(text, pos), Pos: pos})
+}
+
+func (e *Erd) AddGroupKeyValue() {
+	e.currentGroup.GroupAttributes[e.key] = e.value
+}
+
+func (e *Erd) AddTable(text string, pos int) {
+	if e.tableIndex == nil {
+		e.tableIndex = map[string]*Table{}
+	}
+	table := &Table{Title: text, TableAttributes: map[string]string{}, Pos: pos}
+	if _, ok := e.tableIndex[text]; ok {
+		e.duplicateTables = append(e.duplicateTables, table)
+	} else {
+		e.Tables = append(e.Tables, table)
+		e.tableIndex[text] = table
+	}
+	e.CurrentTableName = text
+	e.currentTable = table
+}
+
+func (e *Erd) AddTableKeyValue() {
+	table := e.currentTable
+	if table.TableAttributes == nil {
+		table.TableAttributes = map[string]string{}
+	}
+	table.TableAttributes[e.key] = e.value
+}
+
+func (e *Erd) AddColumn(text string, pos int) {
+	e.closeTableAt(pos)
+	if e.currentTable == nil {
+		e.errorf(pos, pos+runeLen(text), "column %q is declared outside of a table", text)
+		e.currentTable = &Table{Pos: pos}
+	}
+
+	table := e.currentTable
+	table.Columns = append(table.Columns, Column{
+		Title:            text,
+		IsPrimaryKey:     e.isPrimaryKey,
+		IsForeignKey:     e.isForeignKey,
+		ColumnAttributes: map[string]string{},
+		Pos:              pos,
+	})
+	table.CurrentColumnId = len(table.Columns) - 1
+	if e.isPrimaryKey {
+		table.PrimaryKeys = append(table.PrimaryKeys, table.CurrentColumnId)
+	}
+	e.isPrimaryKey = false
+	e.isForeignKey = false
+}
+
+func (e *Erd) SetPrimaryKey() {
+	e.isPrimaryKey = true
+}
+
+func (e *Erd) SetForeignKey() {
+	e.isForeignKey = true
+}
+
+func (e *Erd) currentColumn() *Column {
+	table := e.currentTable
+	return &table.Columns[table.CurrentColumnId]
+}
+
+func (e *Erd) SetColumnType(text string) {
+	e.currentColumn().Type = text
+}
+
+func (e *Erd) SetColumnNotNull(notNull bool) {
+	e.currentColumn().IsNotNull = notNull
+}
+
+func (e *Erd) SetColumnDefault(text string) {
+	e.currentColumn().Default = text
+}
+
+func (e *Erd) AddColumnKeyValue() {
+	column := e.currentColumn()
+	switch e.key {
+	case "type":
+		column.Type = e.value
+	case "null":
+		null, err := strconv.ParseBool(e.value)
+		if err != nil {
+			e.errorf(e.valuePos, e.valuePos+runeLen(e.value), "invalid null attribute %q on column %q", e.value, column.Title)
+			break
+		}
+		column.IsNotNull = !null
+	case "default":
+		column.Default = e.value
+	default:
+		if column.ColumnAttributes == nil {
+			column.ColumnAttributes = map[string]string{}
+		}
+		column.ColumnAttributes[e.key] = e.value
+	}
+	e.key = ""
+	e.value = ""
+}
+
+func (e *Erd) AddIndex(text string, pos int) {
+	e.closeTableAt(pos)
+	if e.currentTable == nil {
+		e.errorf(pos, pos+runeLen(text), "index %q is declared outside of a table", text)
+		e.currentTable = &Table{Pos: pos}
+	}
+	table := e.currentTable
+	table.Indexes = append(table.Indexes, Index{Title: text, IndexAttributes: map[string]string{}, Pos: pos})
+}
+
+func (e *Erd) AddIndexColumn(text string, pos int) {
+	table := e.currentTable
+	index := &table.Indexes[len(table.Indexes)-1]
+	index.Columns = append(index.Columns, text)
+	index.columnPos = append(index.columnPos, pos)
+}
+
+func (e *Erd) AddIndexKeyValue() {
+	table := e.currentTable
+	index := &table.Indexes[len(table.Indexes)-1]
+	switch e.key {
+	case "unique":
+		unique, err := strconv.ParseBool(e.value)
+		if err != nil {
+			e.errorf(e.valuePos, e.valuePos+runeLen(e.value), "invalid unique attribute %q on index %q", e.value, index.Title)
+			break
+		}
+		index.IsUnique = unique
+		index.IndexAttributes[e.key] = e.value
+	default:
+		index.IndexAttributes[e.key] = e.value
+	}
+	e.key = ""
+	e.value = ""
+}
+
+func (e *Erd) SetKey(text string, pos int) {
+	e.key = text
+	if len(e.key) > 0 && e.key[0] == '"' {
+		e.key = e.unquote(e.key, pos)
+	}
+}
+
+func (e *Erd) SetValue(text string, pos int) {
+	e.value = text
+	e.valuePos = pos
+	if len(e.value) > 0 && e.value[0] == '"' {
+		e.value = e.unquote(e.value, pos)
+	}
+}
+
+func (e *Erd) AddRelation() {
+	e.Relations = append(e.Relations, e.CurrentRelation)
+	e.CurrentRelation = Relation{}
+}
+
+func (e *Erd) AddRelationKeyValue() {
+	if e.CurrentRelation.RelationAttributes == nil {
+		e.CurrentRelation.RelationAttributes = map[string]string{}
+	}
+	e.CurrentRelation.RelationAttributes[e.key] = e.value
+}
+
+func (e *Erd) SetRelationLeft(text string, pos int) {
+	e.CurrentRelation.LeftTableName = text
+	e.CurrentRelation.LeftPos = pos
+}
+
+func (e *Erd) SetRelationLeftColumn(text string) {
+	e.CurrentRelation.LeftColumn = text
+}
+
+func (e *Erd) SetCardinalityLeft(text string) {
+	e.CurrentRelation.LeftCardinality = text
+}
+
+func (e *Erd) SetRelationRight(text string, pos int) {
+	e.CurrentRelation.RightTableName = text
+	e.CurrentRelation.RightPos = pos
+}
+
+func (e *Erd) SetRelationRightColumn(text string) {
+	e.CurrentRelation.RightColumn = text
+}
+
+func (e *Erd) SetCardinalityRight(text string) {
+	e.CurrentRelation.RightCardinality = text
+}
+
+func (e *Erd) unquote(str string, pos int) string {
+	s, err := strconv.Unquote(str)
+	if err != nil {
+		e.errorf(pos, pos+runeLen(str), "invalid quoted string %s", str)
+		return str
+	}
+	return s
+}
+
+// Err reports the unparsable text starting at pos as a syntax error.
+func (e *Erd) Err(pos int, buffer string) {
+	runes := []rune(buffer)
+	end := pos
+	for end < len(runes) && runes[end] != '\n' && runes[end] != '\r' {
+		end++
+	}
+	e.errorf(pos, end, "syntax error")
+}
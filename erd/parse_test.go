@@ -1,4 +1,4 @@
-package main_test
+package erd_test
 
 import (
 	"testing"
@@ -0,0 +1,233 @@
+package erd
+
+import (
+	"reflect"
+	"strings"
+	"testing"
+)
+
+func TestParser_columnKeys(t *testing.T) {
+	parser := &Parser{Buffer: "[Person]\n*name\nheight\n*+birth_location_id\n"}
+	parser.Init()
+	if err := parser.Parse(); err != nil {
+		t.Fatal(err)
+	}
+	parser.Execute()
+
+	table := parser.Erd.Table("Person")
+	want := []struct {
+		title        string
+		isPrimaryKey bool
+		isForeignKey bool
+	}{
+		{"name", true, false},
+		{"height", false, false},
+		{"birth_location_id", true, true},
+	}
+	if len(table.Columns) != len(want) {
+		t.Fatalf("got: %v columns\nwant: %v", len(table.Columns), len(want))
+	}
+	for i, w := range want {
+		c := table.Columns[i]
+		if c.Title != w.title || c.IsPrimaryKey != w.isPrimaryKey || c.IsForeignKey != w.isForeignKey {
+			t.Errorf("got: %+v\nwant: %+v", c, w)
+		}
+	}
+	if len(table.PrimaryKeys) != 2 || table.PrimaryKeys[0] != 0 || table.PrimaryKeys[1] != 2 {
+		t.Errorf("got: %v\nwant: %v", table.PrimaryKeys, []int{0, 2})
+	}
+}
+
+func TestParser_relationColumns(t *testing.T) {
+	buffer := "Person.birth_location_id *--1 Location.id\nPerson *--1 Location\n"
+	parser := &Parser{Buffer: buffer}
+	parser.Init()
+	if err := parser.Parse(); err != nil {
+		t.Fatal(err)
+	}
+	parser.Execute()
+
+	want := []Relation{
+		{LeftTableName: "Person", LeftColumn: "birth_location_id", LeftCardinality: "*", RightTableName: "Location", RightColumn: "id", RightCardinality: "1",
+			LeftPos: 0, RightPos: strings.Index(buffer, "Location.id")},
+		{LeftTableName: "Person", LeftCardinality: "*", RightTableName: "Location", RightCardinality: "1",
+			LeftPos: strings.Index(buffer, "Person *"), RightPos: strings.LastIndex(buffer, "Location")},
+	}
+	if !reflect.DeepEqual(parser.Erd.Relations, want) {
+		t.Errorf("got: %+v\nwant: %+v", parser.Erd.Relations, want)
+	}
+}
+
+func TestParser_dottedTables(t *testing.T) {
+	e, err := ParseString("test.er", `[public.users]
+*id
+
+[public.orders]
+*id
++user_id
+
+public.orders *--1 public.users
+"public.orders".user_id *--1 "public.users".id
+public.user *--1 public.users
+`)
+	if err == nil || !strings.Contains(err.Error(), `undefined table "public"`) {
+		t.Errorf("got error %v, want undefined table public", err)
+	}
+	var got []string
+	for _, r := range e.Relations {
+		got = append(got, r.LeftTableName+"|"+r.LeftColumn+" "+r.RightTableName+"|"+r.RightColumn)
+	}
+	want := []string{"public.orders| public.users|", "public.orders|user_id public.users|id", "public|user public.users|"}
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("got %q, want %q", got, want)
+	}
+}
+
+func TestParser_indexes(t *testing.T) {
+	buffer := "[Person]\n*id\nfirst_name\nlast_name\nindex idx_name (first_name, last_name) {unique: true}\nindex idx_last (last_name)\n"
+	parser := &Parser{Buffer: buffer}
+	parser.Init()
+	if err := parser.Parse(); err != nil {
+		t.Fatal(err)
+	}
+	parser.Execute()
+
+	want := []Index{
+		{Title: "idx_name", Columns: []string{"first_name", "last_name"}, IsUnique: true, IndexAttributes: map[string]string{"unique": "true"},
+			Pos: strings.Index(buffer, "idx_name"), columnPos: []int{strings.Index(buffer, "first_name,"), strings.Index(buffer, "last_name)")}},
+		{Title: "idx_last", Columns: []string{"last_name"}, IndexAttributes: map[string]string{},
+			Pos: strings.Index(buffer, "idx_last"), columnPos: []int{strings.LastIndex(buffer, "last_name")}},
+	}
+	if got := parser.Erd.Table("Person").Indexes; !reflect.DeepEqual(got, want) {
+		t.Errorf("got: %+v\nwant: %+v", got, want)
+	}
+}
+
+func TestParser_columnDefinitions(t *testing.T) {
+	buffer := "[Person]\n*id int not null\nname varchar(64) not null default 'x'\nnickname\nscore numeric(10, 2) null default 0 {label: \"points\"}\nbirth {type: date, null: false}\n"
+	parser := &Parser{Buffer: buffer}
+	parser.Init()
+	if err := parser.Parse(); err != nil {
+		t.Fatal(err)
+	}
+	parser.Execute()
+
+	want := []Column{
+		{Title: "id", Type: "int", IsNotNull: true, IsPrimaryKey: true, ColumnAttributes: map[string]string{}, Pos: strings.Index(buffer, "id ")},
+		{Title: "name", Type: "varchar(64)", IsNotNull: true, Default: "'x'", ColumnAttributes: map[string]string{}, Pos: strings.Index(buffer, "name ")},
+		{Title: "nickname", ColumnAttributes: map[string]string{}, Pos: strings.Index(buffer, "nickname")},
+		{Title: "score", Type: "numeric(10, 2)", Default: "0", ColumnAttributes: map[string]string{"label": "points"}, Pos: strings.Index(buffer, "score")},
+		{Title: "birth", Type: "date", IsNotNull: true, ColumnAttributes: map[string]string{}, Pos: strings.Index(buffer, "birth")},
+	}
+	if got := parser.Erd.Table("Person").Columns; !reflect.DeepEqual(got, want) {
+		t.Errorf("got: %+v\nwant: %+v", got, want)
+	}
+}
+
+func TestParser_invalidBooleans(t *testing.T) {
+	// Invalid values leave the earlier ones in place.
+	buffer := "[Person]\n*id int not null\nname {null: false, null: maybe}\nindex idx_id (id) {unique: true, unique: yes}\nindex idx_name (name) {unique: FALSE}\n"
+	e, err := ParseString("test.er", buffer)
+	if err == nil {
+		t.Fatal("no error for invalid booleans")
+	}
+	person := e.Table("Person")
+	if !person.Columns[1].IsNotNull {
+		t.Error("invalid null attribute reset the column to null")
+	}
+	if idx := person.Indexes; !idx[0].IsUnique || idx[0].IndexAttributes["unique"] != "true" || idx[1].IsUnique {
+		t.Errorf("unique: got %+v, want idx_id unique and idx_name not", idx)
+	}
+	var got []string
+	for _, d := range e.Diagnostics {
+		got = append(got, d.Error())
+	}
+	want := []string{
+		`test.er:3:26: error: invalid null attribute "maybe" on column "name"`,
+		`test.er:4:42: error: invalid unique attribute "yes" on index "idx_id"`,
+	}
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("got: %q\nwant: %q", got, want)
+	}
+}
+
+func TestParser_groups(t *testing.T) {
+	buffer := `group "Billing" {label: "Billing & invoices", bgcolor: "#ececfc"}
+group "Empty"
+
+[invoice] {group: Billing}
+*id
+group int
+
+[payment] {group: "Billing"}
+*id
+
+[player] {group: Sports}
+*id
+
+[meta]
+version
+group "Billing"
+`
+	e, err := ParseString("test.er", buffer)
+	if err == nil {
+		t.Fatal("no error for the duplicate group")
+	}
+
+	var got []string
+	for _, g := range e.Groups {
+		var tables []string
+		for _, table := range g.Tables {
+			tables = append(tables, table.Title)
+		}
+		got = append(got, g.Title+": "+strings.Join(tables, " ")+" "+g.GroupAttributes["label"])
+	}
+	want := []string{"Billing: invoice payment Billing & invoices", "Empty:  ", "Sports: player "}
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("got: %q\nwant: %q", got, want)
+	}
+	if g := e.Group("Sports"); g == nil || g.Pos != -1 {
+		t.Errorf("implicit group Sports: %+v", g)
+	}
+	if c := e.Table("invoice").Columns; len(c) != 2 || c[1].Title != "group" || c[1].Type != "int" {
+		t.Errorf("column named group: %+v", c)
+	}
+	if len(e.Table("meta").Columns) != 1 {
+		t.Errorf("group line parsed as a column of meta: %+v", e.Table("meta").Columns)
+	}
+
+	var diags []string
+	for _, d := range e.Diagnostics {
+		diags = append(diags, d.Error())
+	}
+	wantDiags := []string{
+		`test.er:2:7: warning: group "Empty" has no tables`,
+		`test.er:16:7: error: group "Billing" is already declared`,
+	}
+	if !reflect.DeepEqual(diags, wantDiags) {
+		t.Errorf("got: %q\nwant: %q", diags, wantDiags)
+	}
+}
+
+func TestParser_includes(t *testing.T) {
+	buffer := `include "billing.er"
+
+[invoice]
+*id
+include int
+  include "teams/*.er"
+
+invoice *--1 customer
+`
+	e, err := ParseString("test.er", buffer)
+	if err != nil {
+		t.Fatal(err)
+	}
+	want := []Include{{"billing.er", 8}, {"teams/*.er", 58}}
+	if !reflect.DeepEqual(e.Includes, want) {
+		t.Errorf("got %+v, want %+v", e.Includes, want)
+	}
+	if c := e.Table("invoice").Columns; len(c) != 2 || c[1].Title != "include" || c[1].Type != "int" {
+		t.Errorf("columns of invoice: %+v", c)
+	}
+}
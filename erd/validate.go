@@ -0,0 +1,131 @@
+package erd
+
+import (
+	"unicode/utf8"
+)
+
+// Validate checks the model built by Execute for references that can't be
+// resolved and reports them as diagnostics. file is only used to label the
+// diagnostics, buffer must be the text that was parsed.
+func (e *Erd) Validate(file string, buffer string) {
+	for _, dup := range e.duplicateTables {
+		if file, ok := e.declaredElsewhere(e.Table(dup.Title).Pos, dup.Pos); ok {
+			e.errorf(dup.Pos, dup.Pos+runeLen(dup.Title), "table %q is already declared in %s", dup.Title, file)
+			continue
+		}
+		e.errorf(dup.Pos, dup.Pos+runeLen(dup.Title), "table %q is already declared", dup.Title)
+	}
+	for _, dup := range e.duplicateGroups {
+		e.errorf(dup.Pos, dup.Pos+runeLen(dup.Title)+2, "group %q is already declared", dup.Title)
+	}
+	e.resolveGroups()
+
+	for _, table := range e.Tables {
+		seen := map[string]bool{}
+		for _, column := range table.Columns {
+			if seen[column.Title] {
+				e.errorf(column.Pos, column.Pos+runeLen(column.Title), "column %q is already declared in table %q", column.Title, table.Title)
+			}
+			seen[column.Title] = true
+		}
+
+		for _, index := range table.Indexes {
+			for i, column := range index.Columns {
+				if !table.HasColumn(column) {
+					pos := index.columnPos[i]
+					e.errorf(pos, pos+runeLen(column), "index %q references undefined column %q of table %q", index.Title, column, table.Title)
+				}
+			}
+		}
+	}
+
+	for i := range e.Relations {
+		r := &e.Relations[i]
+		r.LeftTableName, r.LeftColumn = e.relationEnd(r.LeftTableName, r.LeftColumn)
+		r.RightTableName, r.RightColumn = e.relationEnd(r.RightTableName, r.RightColumn)
+	}
+	for _, relation := range e.Relations {
+		e.validateRelationEnd(relation.LeftTableName, relation.LeftColumn, relation.LeftPos)
+		e.validateRelationEnd(relation.RightTableName, relation.RightColumn, relation.RightPos)
+	}
+
+	e.locateDiagnostics(file, buffer)
+}
+
+// resolveGroups fills in the tables of each group, adding the groups that
+// are only named by tables. Declared groups without tables are reported.
+func (e *Erd) resolveGroups() {
+	if e.groupIndex == nil {
+		e.groupIndex = map[string]*Group{}
+	}
+	for _, g := range e.Groups {
+		g.Tables = nil
+	}
+	for _, table := range e.Tables {
+		title, ok := table.TableAttributes["group"]
+		if !ok {
+			continue
+		}
+		g := e.groupIndex[title]
+		if g == nil {
+			g = &Group{Title: title, GroupAttributes: map[string]string{}, Pos: -1}
+			e.Groups = append(e.Groups, g)
+			e.groupIndex[title] = g
+		}
+		g.Tables = append(g.Tables, table)
+	}
+	for _, g := range e.Groups {
+		if len(g.Tables) == 0 {
+			e.report(SeverityWarning, g.Pos, g.Pos+runeLen(g.Title)+2, "group %q has no tables", g.Title)
+		}
+	}
+}
+
+// declaredElsewhere returns the file of the rune offset first when the
+// schema was loaded from several files and pos is in another file.
+func (e *Erd) declaredElsewhere(first, pos int) (string, bool) {
+	if len(e.sources) == 0 {
+		return "", false
+	}
+	file, _, _ := e.locate(first)
+	other, _, _ := e.locate(pos)
+	return file, file != other
+}
+
+// relationEnd returns the table and column of a relation endpoint read as
+// table.column. Schemas written before names holding a '.' had to be quoted
+// have endpoints such as public.users, which name the table "public.users"
+// when there is no table "public".
+func (e *Erd) relationEnd(table, column string) (string, string) {
+	if column == "" || e.Table(table) != nil {
+		return table, column
+	}
+	if e.Table(table+"."+column) != nil {
+		return table + "." + column, ""
+	}
+	return table, column
+}
+
+func (e *Erd) validateRelationEnd(tableName, columnName string, pos int) {
+	end := pos + runeLen(tableName)
+	table := e.Table(tableName)
+	if table == nil {
+		if len(e.Includes) > 0 {
+			// The table may be declared in a file that was not read.
+			return
+		}
+		if columnName != "" {
+			e.errorf(pos, end, "relation references undefined table %q; quote table names holding a '.', as in \"%s.%s\"", tableName, tableName, columnName)
+			return
+		}
+		e.errorf(pos, end, "relation references undefined table %q", tableName)
+		return
+	}
+	if columnName != "" && !table.HasColumn(columnName) {
+		e.errorf(pos, end+1+runeLen(columnName), "relation references undefined column %q of table %q", columnName, tableName)
+	}
+}
+
+func runeLen(s string) int {
+	return utf8.RuneCountInString(s)
+}
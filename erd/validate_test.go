@@ -0,0 +1,52 @@
+package erd
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestErd_Validate(t *testing.T) {
+	buffer := `[Person]
+*name
+height
+height
++birth_location_id
+index idx_weight (weight)
+
+[Location]
+*id
+
+[Location]
+*id
+
+Person.birth_location_id *--1 Location.id
+Person *--1 Locaton
+Person.location_id *--1 Location
+`
+	parser := &Parser{Buffer: buffer}
+	parser.Init()
+	if err := parser.Parse(); err != nil {
+		t.Fatal(err)
+	}
+	parser.Execute()
+
+	parser.Erd.Validate("test.er", buffer)
+
+	var got []string
+	for _, d := range parser.Erd.Diagnostics {
+		got = append(got, d.Error())
+	}
+	want := []string{
+		`test.er:4:1: error: column "height" is already declared in table "Person"`,
+		`test.er:6:19: error: index "idx_weight" references undefined column "weight" of table "Person"`,
+		`test.er:11:2: error: table "Location" is already declared`,
+		`test.er:15:13: error: relation references undefined table "Locaton"`,
+		`test.er:16:1: error: relation references undefined column "location_id" of table "Person"`,
+	}
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("got: %q\nwant: %q", got, want)
+	}
+	if !parser.Erd.IsError {
+		t.Error("IsError is not set")
+	}
+}
@@ -0,0 +1,172 @@
+// Package filter narrows an erd model down to the part of the schema around
+// some tables, for diagrams of a single area of a large schema, and splits
+// a schema into one model per group or per connected component.
+package filter
+
+import (
+	"fmt"
+	"path"
+	"strings"
+
+	"github.com/kaishuu0123/erd-go/erd"
+)
+
+// Options selects the tables to keep.
+type Options struct {
+	// Focus holds the patterns of the tables to start from. A pattern is a
+	// table name or a glob as understood by path.Match, "group:NAME" for
+	// the tables of a group or "tag:NAME" for the tables whose tags
+	// attribute lists NAME. NAME may be a glob too.
+	Focus []string
+	// Depth is the number of relation hops from the focused tables within
+	// which tables are kept.
+	Depth int
+	// Stubs keeps the tables one hop further as stubs: tables marked
+	// Stub, without columns or indexes, so that the diagram shows where
+	// it connects to the rest of the schema.
+	Stubs bool
+}
+
+// Apply drops the tables of e that are more than opts.Depth relations away
+// from the tables matching opts.Focus, and the relations and groups that
+// referenced them. It returns an error if a pattern is invalid or matches
+// no table.
+func Apply(e *erd.Erd, opts Options) error {
+	if len(opts.Focus) == 0 {
+		return fmt.Errorf("no tables to focus on")
+	}
+	if opts.Depth < 0 {
+		return fmt.Errorf("invalid depth %d", opts.Depth)
+	}
+
+	distance := map[*erd.Table]int{}
+	var queue []*erd.Table
+	for _, pattern := range opts.Focus {
+		matched := false
+		for _, t := range e.Tables {
+			ok, err := match(pattern, t)
+			if err != nil {
+				return err
+			}
+			if !ok {
+				continue
+			}
+			matched = true
+			if _, seen := distance[t]; !seen {
+				distance[t] = 0
+				queue = append(queue, t)
+			}
+		}
+		if !matched {
+			return fmt.Errorf("no table matches %q", pattern)
+		}
+	}
+
+	// Breadth first search along the relations, in both directions.
+	neighbors := map[*erd.Table][]*erd.Table{}
+	for _, r := range e.Relations {
+		left, right := e.Table(r.LeftTableName), e.Table(r.RightTableName)
+		if left == nil || right == nil || left == right {
+			continue
+		}
+		neighbors[left] = append(neighbors[left], right)
+		neighbors[right] = append(neighbors[right], left)
+	}
+	limit := opts.Depth
+	if opts.Stubs {
+		limit++
+	}
+	for len(queue) > 0 {
+		t := queue[0]
+		queue = queue[1:]
+		if distance[t] >= limit {
+			continue
+		}
+		for _, n := range neighbors[t] {
+			if _, seen := distance[n]; !seen {
+				distance[n] = distance[t] + 1
+				queue = append(queue, n)
+			}
+		}
+	}
+
+	stubs := map[string]bool{}
+	for t, d := range distance {
+		if d > opts.Depth {
+			stubs[t.Title] = true
+			stub(t)
+		}
+	}
+	if len(stubs) > 0 {
+		// Stubs have no columns to attach edges to, and the relations
+		// between two stubs are out of scope.
+		var relations []erd.Relation
+		for _, r := range e.Relations {
+			if stubs[r.LeftTableName] && stubs[r.RightTableName] {
+				continue
+			}
+			if stubs[r.LeftTableName] {
+				r.LeftColumn = ""
+			}
+			if stubs[r.RightTableName] {
+				r.RightColumn = ""
+			}
+			relations = append(relations, r)
+		}
+		e.Relations = relations
+	}
+
+	e.Retain(func(t *erd.Table) bool {
+		_, ok := distance[t]
+		return ok
+	})
+	return nil
+}
+
+// match reports whether t matches pattern.
+func match(pattern string, t *erd.Table) (bool, error) {
+	var names []string
+	glob := pattern
+	switch {
+	case strings.HasPrefix(pattern, "group:"):
+		glob = strings.TrimPrefix(pattern, "group:")
+		if g, ok := t.TableAttributes["group"]; ok {
+			names = []string{g}
+		}
+	case strings.HasPrefix(pattern, "tag:"):
+		glob = strings.TrimPrefix(pattern, "tag:")
+		names = Tags(t)
+	default:
+		names = []string{t.Title}
+	}
+	for _, name := range names {
+		ok, err := path.Match(glob, name)
+		if err != nil {
+			return false, fmt.Errorf("invalid pattern %q: %v", pattern, err)
+		}
+		if ok {
+			return true, nil
+		}
+	}
+	// Check the syntax of patterns that had nothing to match against.
+	if _, err := path.Match(glob, ""); err != nil {
+		return false, fmt.Errorf("invalid pattern %q: %v", pattern, err)
+	}
+	return false, nil
+}
+
+// Tags returns the tags of t, listed in its tags attribute and separated
+// by commas or spaces.
+func Tags(t *erd.Table) []string {
+	return strings.FieldsFunc(t.TableAttributes["tags"], func(r rune) bool {
+		return r == ',' || r == ' '
+	})
+}
+
+// stub turns t into a stub table.
+func stub(t *erd.Table) {
+	t.Stub = true
+	t.Columns = nil
+	t.PrimaryKeys = nil
+	t.Indexes = nil
+}
@@ -0,0 +1,153 @@
+package filter
+
+import (
+	"io/ioutil"
+	"path/filepath"
+	"reflect"
+	"sort"
+	"strings"
+	"testing"
+
+	"github.com/kaishuu0123/erd-go/erd"
+)
+
+func parseNfldb(t *testing.T) *erd.Erd {
+	src, err := ioutil.ReadFile(filepath.Join("..", "examples", "nfldb.er"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	e, err := erd.ParseString("nfldb.er", string(src))
+	if err != nil {
+		t.Fatal(err)
+	}
+	return e
+}
+
+func titles(e *erd.Erd) []string {
+	var list []string
+	for _, t := range e.Tables {
+		list = append(list, t.Title)
+	}
+	sort.Strings(list)
+	return list
+}
+
+func TestApply(t *testing.T) {
+	for _, test := range []struct {
+		focus []string
+		depth int
+		want  []string
+	}{
+		{[]string{"game"}, 0, []string{"game"}},
+		{[]string{"game"}, 1, []string{"drive", "game", "play", "play_player", "team"}},
+		{[]string{"game"}, 2, []string{"drive", "game", "play", "play_player", "player", "team"}},
+		{[]string{"player"}, 1, []string{"play_player", "player", "team"}},
+		{[]string{"play_*"}, 0, []string{"play_player"}},
+		{[]string{"meta", "team"}, 0, []string{"meta", "team"}},
+	} {
+		e := parseNfldb(t)
+		if err := Apply(e, Options{Focus: test.focus, Depth: test.depth}); err != nil {
+			t.Fatal(err)
+		}
+		if got := titles(e); !reflect.DeepEqual(got, test.want) {
+			t.Errorf("%v depth %d: got %v, want %v", test.focus, test.depth, got, test.want)
+		}
+		for _, r := range e.Relations {
+			if e.Table(r.LeftTableName) == nil || e.Table(r.RightTableName) == nil {
+				t.Errorf("%v depth %d: relation %s--%s to a dropped table", test.focus, test.depth, r.LeftTableName, r.RightTableName)
+			}
+		}
+	}
+}
+
+func TestApply_stubs(t *testing.T) {
+	e := parseNfldb(t)
+	if err := Apply(e, Options{Focus: []string{"player"}, Depth: 0, Stubs: true}); err != nil {
+		t.Fatal(err)
+	}
+	if got, want := titles(e), []string{"play_player", "player", "team"}; !reflect.DeepEqual(got, want) {
+		t.Fatalf("got %v, want %v", got, want)
+	}
+	if p := e.Table("player"); p.Stub || len(p.Columns) == 0 {
+		t.Errorf("player is a stub: %+v", p)
+	}
+	for _, name := range []string{"play_player", "team"} {
+		s := e.Table(name)
+		if !s.Stub || len(s.Columns) != 0 || len(s.Indexes) != 0 {
+			t.Errorf("%s is not a stub: %+v", name, s)
+		}
+	}
+	for _, r := range e.Relations {
+		if r.LeftTableName != "player" && r.RightTableName != "player" {
+			t.Errorf("relation between stubs %s--%s", r.LeftTableName, r.RightTableName)
+		}
+		if r.LeftTableName != "player" && r.LeftColumn != "" || r.RightTableName != "player" && r.RightColumn != "" {
+			t.Errorf("relation %s--%s has a column on a stub", r.LeftTableName, r.RightTableName)
+		}
+	}
+	if len(e.Relations) == 0 {
+		t.Error("no relations left")
+	}
+}
+
+func TestApply_groupsAndTags(t *testing.T) {
+	src := `group "Billing"
+
+[invoice] {group: Billing, tags: "finance, core"}
+*id
+
+[payment] {group: Billing}
+*id
+
+[user] {tags: core}
+*id
+
+[log]
+*id
+
+invoice *--1 user
+`
+	for _, test := range []struct {
+		focus  string
+		want   []string
+		groups int
+	}{
+		{"group:Billing", []string{"invoice", "payment"}, 1},
+		{"group:Bill*", []string{"invoice", "payment"}, 1},
+		{"tag:core", []string{"invoice", "user"}, 1},
+		{"tag:finance", []string{"invoice"}, 1},
+		{"user", []string{"user"}, 0},
+	} {
+		e, err := erd.ParseString("test.er", src)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if err := Apply(e, Options{Focus: []string{test.focus}}); err != nil {
+			t.Fatal(err)
+		}
+		if got := titles(e); !reflect.DeepEqual(got, test.want) {
+			t.Errorf("%s: got %v, want %v", test.focus, got, test.want)
+		}
+		if len(e.Groups) != test.groups {
+			t.Errorf("%s: %d groups, want %d", test.focus, len(e.Groups), test.groups)
+		}
+	}
+}
+
+func TestApply_errors(t *testing.T) {
+	for _, test := range []struct {
+		opts Options
+		want string
+	}{
+		{Options{Focus: []string{"nope"}}, `no table matches "nope"`},
+		{Options{Focus: []string{"[game"}}, `invalid pattern "[game"`},
+		{Options{Focus: []string{"group:["}}, `invalid pattern "group:["`},
+		{Options{}, "no tables to focus on"},
+		{Options{Focus: []string{"game"}, Depth: -1}, "invalid depth -1"},
+	} {
+		err := Apply(parseNfldb(t), test.opts)
+		if err == nil || !strings.Contains(err.Error(), test.want) {
+			t.Errorf("%+v: got %v, want %s", test.opts, err, test.want)
+		}
+	}
+}
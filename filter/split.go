@@ -0,0 +1,142 @@
+package filter
+
+import (
+	"fmt"
+	"strings"
+	"unicode"
+
+	"github.com/kaishuu0123/erd-go/erd"
+)
+
+// Part is one of the diagrams a schema is split into.
+type Part struct {
+	// Name is a file name for the part, without extension. It is unique
+	// among the parts of a split.
+	Name string
+	// Title describes the part: the label of its group, or the name of the
+	// first table of its component.
+	Title string
+	// Erd holds the tables of the part, the relations between them and a
+	// title label naming the part.
+	Erd *erd.Erd
+}
+
+// Split divides the tables of e into parts, by "group" or by "component".
+// Each group makes a part, followed by a part of the tables outside any
+// group. Components are the sets of tables connected by relations. The
+// relations between tables of different parts are left out.
+func Split(e *erd.Erd, by string) ([]Part, error) {
+	switch by {
+	case "group":
+		return splitByGroup(e)
+	case "component":
+		return splitByComponent(e), nil
+	}
+	return nil, fmt.Errorf("cannot split by %q, want group or component", by)
+}
+
+func splitByGroup(e *erd.Erd) ([]Part, error) {
+	if len(e.Groups) == 0 {
+		return nil, fmt.Errorf("the schema has no groups to split by")
+	}
+	names := map[string]bool{}
+	var parts []Part
+	for _, g := range e.Groups {
+		title := g.GroupAttributes["label"]
+		if title == "" {
+			title = g.Title
+		}
+		group := g.Title
+		parts = append(parts, part(e, names, g.Title, title, func(t *erd.Table) bool {
+			g, ok := t.TableAttributes["group"]
+			return ok && g == group
+		}))
+	}
+	for _, t := range e.Tables {
+		if _, ok := t.TableAttributes["group"]; !ok {
+			parts = append(parts, part(e, names, "ungrouped", "Other tables", func(t *erd.Table) bool {
+				_, ok := t.TableAttributes["group"]
+				return !ok
+			}))
+			break
+		}
+	}
+	return parts, nil
+}
+
+func splitByComponent(e *erd.Erd) []Part {
+	// Union-find over the relations.
+	parent := map[string]string{}
+	var root func(name string) string
+	root = func(name string) string {
+		p, ok := parent[name]
+		if !ok || p == name {
+			return name
+		}
+		r := root(p)
+		parent[name] = r
+		return r
+	}
+	for _, r := range e.Relations {
+		if e.Table(r.LeftTableName) == nil || e.Table(r.RightTableName) == nil {
+			continue
+		}
+		left, right := root(r.LeftTableName), root(r.RightTableName)
+		if left != right {
+			parent[right] = left
+		}
+	}
+
+	names := map[string]bool{}
+	done := map[string]bool{}
+	var parts []Part
+	for _, t := range e.Tables {
+		r := root(t.Title)
+		if done[r] {
+			continue
+		}
+		done[r] = true
+		parts = append(parts, part(e, names, t.Title, t.Title, func(t *erd.Table) bool {
+			return root(t.Title) == r
+		}))
+	}
+	return parts
+}
+
+// part returns the part of e holding the tables for which keep returns
+// true. names holds the names already taken by other parts.
+func part(e *erd.Erd, names map[string]bool, name, title string, keep func(t *erd.Table) bool) Part {
+	sub := e.Subset(keep)
+	attrs := map[string]string{}
+	for k, v := range e.Title.TitleAttributes {
+		attrs[k] = v
+	}
+	if label := attrs["label"]; label != "" {
+		attrs["label"] = label + ": " + title
+	} else {
+		attrs["label"] = title
+	}
+	sub.Title.TitleAttributes = attrs
+	return Part{Name: fileName(names, name), Title: title, Erd: sub}
+}
+
+// fileName turns name into a file name made of letters, digits, '-' and
+// '_' that is not in taken, and adds it to taken.
+func fileName(taken map[string]bool, name string) string {
+	base := strings.Trim(strings.Map(func(r rune) rune {
+		if unicode.IsLetter(r) || unicode.IsDigit(r) || r == '_' || r == '-' {
+			return r
+		}
+		return '-'
+	}, name), "-")
+	if base == "" {
+		base = "part"
+	}
+	n := base
+	for i := 2; taken[strings.ToLower(n)]; i++ {
+		n = fmt.Sprintf("%s-%d", base, i)
+	}
+	// Compare without case for case insensitive file systems.
+	taken[strings.ToLower(n)] = true
+	return n
+}
@@ -0,0 +1,132 @@
+package filter
+
+import (
+	"reflect"
+	"strings"
+	"testing"
+
+	"github.com/kaishuu0123/erd-go/erd"
+)
+
+const splitSchema = `title {label: "Shop"}
+
+group "Billing" {label: "Billing and invoices"}
+
+[invoice] {group: Billing}
+*id
++customer_id
+
+[payment] {group: Billing}
+*id
++invoice_id
+
+[customer] {group: "CRM"}
+*id
+
+[log]
+*id
+
+[audit]
+*id
++log_id
+
+invoice *--1 customer
+payment *--1 invoice
+audit *--1 log
+`
+
+func TestSplit(t *testing.T) {
+	for _, test := range []struct {
+		by     string
+		names  []string
+		titles []string
+		tables [][]string
+		labels []string
+	}{
+		{
+			by:     "group",
+			names:  []string{"Billing", "CRM", "ungrouped"},
+			titles: []string{"Billing and invoices", "CRM", "Other tables"},
+			tables: [][]string{{"invoice", "payment"}, {"customer"}, {"log", "audit"}},
+			labels: []string{"Shop: Billing and invoices", "Shop: CRM", "Shop: Other tables"},
+		},
+		{
+			by:     "component",
+			names:  []string{"invoice", "log"},
+			titles: []string{"invoice", "log"},
+			tables: [][]string{{"invoice", "payment", "customer"}, {"log", "audit"}},
+			labels: []string{"Shop: invoice", "Shop: log"},
+		},
+	} {
+		e, err := erd.ParseString("test.er", splitSchema)
+		if err != nil {
+			t.Fatal(err)
+		}
+		parts, err := Split(e, test.by)
+		if err != nil {
+			t.Fatal(err)
+		}
+		var names, titles, labels []string
+		var tables [][]string
+		relations := 0
+		for _, p := range parts {
+			names = append(names, p.Name)
+			titles = append(titles, p.Title)
+			labels = append(labels, p.Erd.Title.TitleAttributes["label"])
+			var list []string
+			for _, t := range p.Erd.Tables {
+				list = append(list, t.Title)
+			}
+			tables = append(tables, list)
+			relations += len(p.Erd.Relations)
+		}
+		if !reflect.DeepEqual(names, test.names) {
+			t.Errorf("%s: names %v, want %v", test.by, names, test.names)
+		}
+		if !reflect.DeepEqual(titles, test.titles) {
+			t.Errorf("%s: titles %v, want %v", test.by, titles, test.titles)
+		}
+		if !reflect.DeepEqual(tables, test.tables) {
+			t.Errorf("%s: tables %v, want %v", test.by, tables, test.tables)
+		}
+		if !reflect.DeepEqual(labels, test.labels) {
+			t.Errorf("%s: labels %v, want %v", test.by, labels, test.labels)
+		}
+
+		// The model that was split is left as it was.
+		if len(e.Tables) != 5 || len(e.Relations) != 3 || len(e.Groups) != 2 || len(e.Groups[0].Tables) != 2 {
+			t.Errorf("%s: split changed the model", test.by)
+		}
+		if e.Title.TitleAttributes["label"] != "Shop" {
+			t.Errorf("%s: split changed the title to %q", test.by, e.Title.TitleAttributes["label"])
+		}
+		if test.by == "component" && relations != 3 {
+			t.Errorf("%s: %d relations in the parts, want 3", test.by, relations)
+		}
+	}
+}
+
+func TestSplit_errors(t *testing.T) {
+	e, err := erd.ParseString("test.er", "[a]\n*id\n")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if _, err := Split(e, "group"); err == nil || !strings.Contains(err.Error(), "no groups") {
+		t.Errorf("got %v, want an error about groups", err)
+	}
+	if _, err := Split(e, "nope"); err == nil {
+		t.Error("no error for an unknown split")
+	}
+}
+
+func TestFileName(t *testing.T) {
+	taken := map[string]bool{}
+	var got []string
+	for _, name := range []string{"Billing", "billing", "a b/c", "???", "Bill", "billing"} {
+		got = append(got, fileName(taken, name))
+	}
+	want := []string{"Billing", "billing-2", "a-b-c", "part", "Bill", "billing-3"}
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("got %v, want %v", got, want)
+	}
+}
@@ -0,0 +1,105 @@
+package main
+
+import (
+	"bytes"
+	"fmt"
+	"io/ioutil"
+	"os"
+
+	"github.com/kaishuu0123/erd-go/erd"
+	"github.com/kaishuu0123/erd-go/render/er"
+)
+
+type FmtCommand struct {
+	Write bool `short:"w" description:"write the result to the files instead of stdout"`
+	Check bool `long:"check" description:"list the files that are not formatted and exit with status 1 if there are any"`
+	Args  struct {
+		Files []string `positional-arg-name:"FILE"`
+	} `positional-args:"yes"`
+}
+
+var fmtCommand FmtCommand
+
+// runFmt formats the files of the fmt command, or stdin when there are none
+// or for "-", and returns the exit status.
+func runFmt() int {
+	names := fmtCommand.Args.Files
+	if len(names) == 0 {
+		names = []string{"-"}
+	}
+
+	status := 0
+	for _, name := range names {
+		if name == "-" {
+			src, err := ioutil.ReadAll(os.Stdin)
+			if err != nil {
+				fmt.Fprintln(os.Stderr, err)
+				status = 1
+				continue
+			}
+			// stdin can only be written to stdout.
+			if s := formatFile("<stdin>", src, false); s != 0 {
+				status = s
+			}
+			continue
+		}
+		src, err := ioutil.ReadFile(name)
+		if err != nil {
+			fmt.Fprintln(os.Stderr, err)
+			status = 1
+			continue
+		}
+		if s := formatFile(name, src, fmtCommand.Write); s != 0 {
+			status = s
+		}
+	}
+	return status
+}
+
+func formatFile(name string, src []byte, write bool) int {
+	out, err := er.Format(name, src)
+	if err != nil {
+		if errs, ok := err.(erd.ErrorList); ok {
+			err = writeDiagnostics(errs)
+		}
+		if err != nil {
+			fmt.Fprintln(os.Stderr, err)
+		}
+		return 1
+	}
+
+	changed := !bytes.Equal(src, out)
+	if fmtCommand.Check {
+		if !changed {
+			return 0
+		}
+		fmt.Println(name)
+		if write {
+			writeFile(name, out)
+		}
+		return 1
+	}
+	if write {
+		if !changed {
+			return 0
+		}
+		return writeFile(name, out)
+	}
+	if _, err := os.Stdout.Write(out); err != nil {
+		fmt.Fprintln(os.Stderr, err)
+		return 1
+	}
+	return 0
+}
+
+func writeFile(name string, data []byte) int {
+	mode := os.FileMode(0644)
+	if fi, err := os.Stat(name); err == nil {
+		mode = fi.Mode().Perm()
+	}
+	if err := ioutil.WriteFile(name, data, mode); err != nil {
+		fmt.Fprintln(os.Stderr, err)
+		return 1
+	}
+	return 0
+}
@@ -0,0 +1,46 @@
+// Package importer maps input format names to the packages that build an
+// erd model from them.
+package importer
+
+import (
+	"fmt"
+
+	"github.com/kaishuu0123/erd-go/erd"
+	"github.com/kaishuu0123/erd-go/importer/sql"
+	"github.com/kaishuu0123/erd-go/schema"
+)
+
+// Func builds the model of src. name labels the diagnostics. Like
+// erd.ParseString it returns the model along with an erd.ErrorList when src
+// has errors.
+type Func func(name string, src string) (*erd.Erd, error)
+
+// Format is an input format selectable with import --from.
+type Format struct {
+	Name        string
+	Description string
+	Import      Func
+}
+
+// formats lists the input formats sorted by name.
+var formats = []*Format{
+	{Name: "er", Description: "erd-go schema", Import: erd.ParseString},
+	{Name: "json", Description: "JSON document of the model, see package schema", Import: schema.ParseJSON},
+	{Name: "sql", Description: "SQL CREATE TABLE, CREATE INDEX and ALTER TABLE statements", Import: sql.Parse},
+	{Name: "yaml", Description: "YAML document of the model, see package schema", Import: schema.ParseYAML},
+}
+
+// Lookup returns the format called name.
+func Lookup(name string) (*Format, error) {
+	for _, f := range formats {
+		if f.Name == name {
+			return f, nil
+		}
+	}
+	return nil, fmt.Errorf("unknown input format %q", name)
+}
+
+// Formats returns the input formats sorted by name.
+func Formats() []*Format {
+	return append([]*Format(nil), formats...)
+}
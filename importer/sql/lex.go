@@ -0,0 +1,124 @@
+package sql
+
+import (
+	"strings"
+	"unicode"
+)
+
+type tokenKind int
+
+const (
+	tokenWord   tokenKind = iota // keywords and bare identifiers
+	tokenQuoted                  // "ident", `ident` or [ident]
+	tokenString                  // 'text'
+	tokenNumber
+	tokenPunct
+)
+
+// token is a lexical element of the SQL source. begin and end are rune
+// offsets, which is what the diagnostics of the model use.
+type token struct {
+	kind       tokenKind
+	text       string
+	begin, end int
+	// unterminated is set on quoted tokens that run to the end of the
+	// source without their closing quote.
+	unterminated bool
+}
+
+// is reports whether t is the keyword kw, ignoring case.
+func (t token) is(kw string) bool {
+	return t.kind == tokenWord && strings.EqualFold(t.text, kw)
+}
+
+// name returns the identifier t stands for, without its quotes.
+func (t token) name() string {
+	if t.kind != tokenQuoted {
+		return t.text
+	}
+	s := t.text[1:]
+	if !t.unterminated {
+		s = s[:len(s)-1]
+	}
+	switch t.text[0] {
+	case '"':
+		return strings.Replace(s, `""`, `"`, -1)
+	case '`':
+		return strings.Replace(s, "``", "`", -1)
+	}
+	return s
+}
+
+func isWordRune(r rune) bool {
+	return r == '_' || r == '$' || unicode.IsLetter(r) || unicode.IsDigit(r)
+}
+
+// lex splits src into tokens, dropping white space and comments. An
+// unterminated quote or comment runs to the end of the source.
+func lex(src []rune) []token {
+	var tokens []token
+	for i := 0; i < len(src); {
+		r := src[i]
+		begin := i
+		kind := tokenPunct
+		unterminated := false
+		switch {
+		case unicode.IsSpace(r):
+			i++
+			continue
+		case r == '-' && i+1 < len(src) && src[i+1] == '-':
+			for i < len(src) && src[i] != '\n' {
+				i++
+			}
+			continue
+		case r == '/' && i+1 < len(src) && src[i+1] == '*':
+			i += 2
+			for i < len(src) && !(src[i] == '*' && i+1 < len(src) && src[i+1] == '/') {
+				i++
+			}
+			i += 2
+			if i > len(src) {
+				i = len(src)
+			}
+			continue
+		case r == '\'' || r == '"' || r == '`' || r == '[':
+			kind = tokenQuoted
+			if r == '\'' {
+				kind = tokenString
+			}
+			closing := r
+			if r == '[' {
+				closing = ']'
+			}
+			i++
+			unterminated = true
+			for i < len(src) {
+				if src[i] == closing {
+					// A doubled quote stands for the quote itself.
+					if closing != ']' && i+1 < len(src) && src[i+1] == closing {
+						i += 2
+						continue
+					}
+					i++
+					unterminated = false
+					break
+				}
+				i++
+			}
+		case unicode.IsDigit(r):
+			kind = tokenNumber
+			for i < len(src) && (unicode.IsDigit(src[i]) || src[i] == '.') {
+				i++
+			}
+		case isWordRune(r):
+			kind = tokenWord
+			for i < len(src) && isWordRune(src[i]) {
+				i++
+			}
+		default:
+			i++
+		}
+		tokens = append(tokens, token{kind: kind, text: string(src[begin:i]), begin: begin, end: i, unterminated: unterminated})
+	}
+	return tokens
+}
@@ -0,0 +1,640 @@
+// Package sql builds an erd model from the CREATE TABLE, CREATE INDEX and
+// ALTER TABLE ... ADD statements of a SQL schema dump. Other statements are
+// skipped.
+package sql
+
+import (
+	"strings"
+
+	"github.com/kaishuu0123/erd-go/erd"
+)
+
+type column struct {
+	name         string
+	typ          string
+	notNull      bool
+	def          string
+	isPrimaryKey bool
+	isForeignKey bool
+	pos          int
+}
+
+type index struct {
+	name    string
+	columns []keyPart
+	unique  bool
+	pos     int
+}
+
+type foreignKey struct {
+	columns    []keyPart
+	refTable   string
+	refColumns []keyPart
+	pos        int
+}
+
+// keyPart is an entry of the column list of a key or an index.
+type keyPart struct {
+	name string
+	pos  int
+	// expr is set for an expression such as lower(name), whose name is the
+	// first column it uses.
+	expr bool
+}
+
+func names(parts []keyPart) []string {
+	list := make([]string, len(parts))
+	for i, p := range parts {
+		list[i] = p.name
+	}
+	return list
+}
+
+type table struct {
+	name        string
+	columns     []*column
+	primaryKey  []keyPart
+	indexes     []*index
+	foreignKeys []*foreignKey
+	pos         int
+}
+
+func (t *table) column(name string) *column {
+	for _, c := range t.columns {
+		if strings.EqualFold(c.name, name) {
+			return c
+		}
+	}
+	return nil
+}
+
+// schema collects the tables of the dump until the model is built.
+type schema struct {
+	src    []rune
+	e      *erd.Erd
+	tables []*table
+	index  map[string]*table
+}
+
+func (s *schema) table(name string) *table {
+	return s.index[strings.ToLower(name)]
+}
+
+// Parse builds the model of the SQL schema in src. name labels the
+// diagnostics. Like erd.ParseString it returns an erd.ErrorList along with
+// the model when some statements could not be understood.
+func Parse(name string, src string) (*erd.Erd, error) {
+	s := &schema{src: []rune(src), e: &erd.Erd{}, index: map[string]*table{}}
+	tokens := lex(s.src)
+	for _, t := range tokens {
+		if !t.unterminated {
+			continue
+		}
+		if t.kind == tokenString {
+			s.e.Errorf(t.begin, t.end, "unterminated string")
+		} else {
+			s.e.Errorf(t.begin, t.end, "unterminated quoted identifier")
+		}
+	}
+	for _, stmt := range statements(tokens) {
+		s.statement(&cursor{s: s, tokens: stmt})
+	}
+	s.build()
+	s.e.Validate(name, src)
+	return s.e, s.e.Errors()
+}
+
+// statements splits tokens at the semicolons outside of parentheses.
+func statements(tokens []token) [][]token {
+	var stmts [][]token
+	depth, start := 0, 0
+	for i, t := range tokens {
+		switch t.text {
+		case "(":
+			depth++
+		case ")":
+			depth--
+		case ";":
+			if depth <= 0 {
+				if i > start {
+					stmts = append(stmts, tokens[start:i])
+				}
+				start, depth = i+1, 0
+			}
+		}
+	}
+	if start < len(tokens) {
+		stmts = append(stmts, tokens[start:])
+	}
+	return stmts
+}
+
+// cursor walks the tokens of one statement.
+type cursor struct {
+	s      *schema
+	tokens []token
+	i      int
+	failed bool
+}
+
+func (c *cursor) done() bool {
+	return c.failed || c.i >= len(c.tokens)
+}
+
+func (c *cursor) peek() token {
+	if c.done() {
+		return token{}
+	}
+	return c.tokens[c.i]
+}
+
+// lookahead reports whether the token n places ahead is text.
+func (c *cursor) lookahead(n int, text string) bool {
+	return c.i+n < len(c.tokens) && c.tokens[c.i+n].text == text
+}
+
+// isName reports whether the token n places ahead can be a name.
+func (c *cursor) isName(n int) bool {
+	return c.i+n < len(c.tokens) && (c.tokens[c.i+n].kind == tokenWord || c.tokens[c.i+n].kind == tokenQuoted)
+}
+
+func (c *cursor) next() token {
+	t := c.peek()
+	c.i++
+	return t
+}
+
+// accept consumes the keywords kws if they come next.
+func (c *cursor) accept(kws ...string) bool {
+	if c.i+len(kws) > len(c.tokens) || c.failed {
+		return false
+	}
+	for j, kw := range kws {
+		t := c.tokens[c.i+j]
+		if !t.is(kw) && !(t.kind == tokenPunct && t.text == kw) {
+			return false
+		}
+	}
+	c.i += len(kws)
+	return true
+}
+
+// fail reports a syntax error at the current token and abandons the rest
+// of the statement.
+func (c *cursor) fail(format string, a ...interface{}) {
+	if c.failed {
+		return
+	}
+	t := c.peek()
+	if c.i >= len(c.tokens) {
+		last := c.tokens[len(c.tokens)-1]
+		t = token{begin: last.end, end: last.end}
+	}
+	c.s.e.Errorf(t.begin, t.end, format, a...)
+	c.failed = true
+}
+
+func (c *cursor) expect(kw string) {
+	if !c.accept(kw) {
+		c.fail("expected %s", kw)
+	}
+}
+
+// name reads a possibly schema qualified name and returns its last part.
+func (c *cursor) name() (string, int) {
+	t := c.next()
+	if t.kind != tokenWord && t.kind != tokenQuoted {
+		c.i--
+		c.fail("expected a name")
+		return "", 0
+	}
+	for c.peek().text == "." {
+		c.i++
+		t = c.next()
+	}
+	return t.name(), t.begin
+}
+
+// skip consumes a token, or a whole parenthesized group.
+func (c *cursor) skip() {
+	depth := 0
+	for !c.done() {
+		switch c.next().text {
+		case "(":
+			depth++
+		case ")":
+			depth--
+		}
+		if depth <= 0 {
+			return
+		}
+	}
+}
+
+// text consumes tokens up to the next ',' or ')' outside of parentheses or
+// one of the keywords stop, and returns their source with white space
+// collapsed.
+func (c *cursor) text(stop ...string) string {
+	start := c.i
+	for !c.done() {
+		t := c.peek()
+		if t.text == "," || t.text == ")" {
+			break
+		}
+		stopped := false
+		for _, kw := range stop {
+			if t.is(kw) {
+				stopped = true
+			}
+		}
+		if stopped {
+			break
+		}
+		c.skip()
+	}
+	if c.i == start {
+		return ""
+	}
+	src := string(c.s.src[c.tokens[start].begin:c.tokens[c.i-1].end])
+	return strings.Join(strings.Fields(src), " ")
+}
+
+// nameList reads "(a, b, ...)". Index entries like "name(10) desc" are
+// the column they start with, and expressions like "lower(name)" the first
+// column name they contain.
+func (c *cursor) nameList() []keyPart {
+	c.expect("(")
+	var parts []keyPart
+	for !c.done() {
+		start := c.i
+		for !c.done() && c.peek().text != "," && c.peek().text != ")" {
+			c.skip()
+		}
+		for j := start; j < c.i; j++ {
+			call := j+2 < c.i && c.tokens[j+1].text == "(" && c.tokens[j+2].kind != tokenNumber
+			if (c.tokens[j].kind == tokenWord || c.tokens[j].kind == tokenQuoted) && !call {
+				parts = append(parts, keyPart{name: c.tokens[j].name(), pos: c.tokens[j].begin, expr: j > start})
+				break
+			}
+		}
+		if c.accept(")") {
+			return parts
+		}
+		c.expect(",")
+	}
+	return parts
+}
+
+func (c *cursor) ifNotExists() {
+	c.accept("if", "not", "exists")
+}
+
+func (s *schema) statement(c *cursor) {
+	switch {
+	case c.accept("create"):
+		c.accept("or", "replace")
+		for c.accept("global") || c.accept("local") || c.accept("temp") || c.accept("temporary") || c.accept("unlogged") {
+		}
+		switch {
+		case c.accept("table"):
+			s.createTable(c)
+		case c.accept("unique"):
+			c.expect("index")
+			s.createIndex(c, true)
+		case c.accept("index"):
+			s.createIndex(c, false)
+		}
+	case c.accept("alter", "table"):
+		s.alterTable(c)
+	}
+}
+
+func (s *schema) createTable(c *cursor) {
+	c.ifNotExists()
+	name, pos := c.name()
+	if c.failed {
+		return
+	}
+	if s.table(name) != nil {
+		s.e.Errorf(pos, pos+len([]rune(name)), "table %q is already created", name)
+		return
+	}
+	t := &table{name: name, pos: pos}
+	if c.accept("as") {
+		c.fail("CREATE TABLE ... AS is not supported")
+		return
+	}
+	c.expect("(")
+	closed := false
+	for !c.done() && !closed {
+		if !s.tableConstraint(c, t) {
+			s.columnDefinition(c, t)
+		}
+		for !c.done() && c.peek().text != "," && c.peek().text != ")" {
+			c.skip()
+		}
+		if closed = c.accept(")"); !closed {
+			c.expect(",")
+		}
+	}
+	if !closed {
+		c.expect(")")
+	}
+	if c.failed {
+		return
+	}
+	s.tables = append(s.tables, t)
+	s.index[strings.ToLower(name)] = t
+}
+
+var columnConstraints = []string{
+	"constraint", "not", "null", "default", "primary", "unique", "references",
+	"check", "collate", "auto_increment", "autoincrement", "generated", "comment",
+}
+
+func (s *schema) columnDefinition(c *cursor, t *table) {
+	name, pos := c.name()
+	if c.failed {
+		return
+	}
+	col := &column{name: name, pos: pos}
+	t.columns = append(t.columns, col)
+	col.typ = c.text(columnConstraints...)
+
+	for !c.done() && c.peek().text != "," && c.peek().text != ")" {
+		switch {
+		case c.accept("constraint"):
+			c.name()
+		case c.accept("not", "null"):
+			col.notNull = true
+		case c.accept("null"):
+			col.notNull = false
+		case c.accept("default"):
+			col.def = c.text(columnConstraints...)
+		case c.accept("primary", "key"):
+			col.isPrimaryKey = true
+			t.primaryKey = append(t.primaryKey, keyPart{name: name, pos: pos})
+		case c.accept("unique"):
+			c.accept("key")
+			t.indexes = append(t.indexes, &index{name: t.name + "_" + name + "_key", columns: []keyPart{{name: name, pos: pos}}, unique: true, pos: pos})
+		case c.peek().is("references"):
+			fk := &foreignKey{columns: []keyPart{{name: name, pos: pos}}, pos: pos}
+			s.references(c, fk)
+			t.foreignKeys = append(t.foreignKeys, fk)
+		default:
+			c.skip()
+		}
+	}
+}
+
+// tableConstraint reads a table constraint or a MySQL index definition if
+// one comes next.
+func (s *schema) tableConstraint(c *cursor, t *table) bool {
+	start := c.i
+	pos := c.peek().begin
+	constraint := ""
+	if c.accept("constraint") {
+		constraint, _ = c.name()
+	}
+	// KEY, INDEX, FULLTEXT and SPATIAL are also fine column names, they
+	// only start an index when a list of column names follows.
+	isIndex := c.lookahead(1, "(") || c.lookahead(2, "(") && c.isName(3)
+	switch {
+	case c.accept("primary", "key"):
+		t.primaryKey = append(t.primaryKey, c.nameList()...)
+	case c.accept("foreign", "key"):
+		if c.peek().text != "(" {
+			c.name()
+		}
+		fk := &foreignKey{columns: c.nameList(), pos: pos}
+		s.references(c, fk)
+		t.foreignKeys = append(t.foreignKeys, fk)
+	case c.accept("unique"):
+		_ = c.accept("key") || c.accept("index")
+		if c.peek().text != "(" {
+			constraint, _ = c.name()
+		}
+		idx := &index{name: constraint, columns: c.nameList(), unique: true, pos: pos}
+		if idx.name == "" {
+			idx.name = t.name + "_" + strings.Join(names(idx.columns), "_") + "_key"
+		}
+		t.indexes = append(t.indexes, idx)
+	case isIndex && (c.accept("key") || c.accept("index")):
+		name := ""
+		if c.peek().text != "(" {
+			name, _ = c.name()
+		}
+		idx := &index{name: name, columns: c.nameList(), pos: pos}
+		if idx.name == "" {
+			idx.name = t.name + "_" + strings.Join(names(idx.columns), "_") + "_idx"
+		}
+		t.indexes = append(t.indexes, idx)
+	case (isIndex || c.lookahead(3, "(")) && (c.accept("fulltext") || c.accept("spatial")):
+		// Not meaningful in a diagram.
+	case c.accept("check"), c.accept("exclude"):
+	default:
+		if constraint == "" {
+			c.i = start
+			return false
+		}
+		c.fail("expected a table constraint")
+	}
+	return true
+}
+
+func (s *schema) references(c *cursor, fk *foreignKey) {
+	c.expect("references")
+	fk.refTable, _ = c.name()
+	if c.peek().text == "(" {
+		fk.refColumns = c.nameList()
+	}
+}
+
+func (s *schema) createIndex(c *cursor, unique bool) {
+	c.accept("concurrently")
+	c.ifNotExists()
+	name := ""
+	pos := c.peek().begin
+	if !c.peek().is("on") {
+		name, pos = c.name()
+	}
+	c.expect("on")
+	c.accept("only")
+	tableName, tablePos := c.name()
+	if c.accept("using") {
+		c.next()
+	}
+	columns := c.nameList()
+	if c.failed {
+		return
+	}
+	t := s.table(tableName)
+	if t == nil {
+		s.e.Errorf(tablePos, tablePos+len([]rune(tableName)), "index on undefined table %q", tableName)
+		return
+	}
+	if name == "" {
+		name = t.name + "_" + strings.Join(names(columns), "_") + "_idx"
+	}
+	t.indexes = append(t.indexes, &index{name: name, columns: columns, unique: unique, pos: pos})
+}
+
+func (s *schema) alterTable(c *cursor) {
+	c.accept("if", "exists")
+	c.accept("only")
+	name, pos := c.name()
+	if c.failed {
+		return
+	}
+	t := s.table(name)
+	if t == nil {
+		s.e.Errorf(pos, pos+len([]rune(name)), "ALTER TABLE of undefined table %q", name)
+		return
+	}
+	for !c.done() {
+		// Only additions matter, other alterations are skipped.
+		if c.accept("add") {
+			c.accept("column")
+			c.ifNotExists()
+			if !s.tableConstraint(c, t) {
+				s.columnDefinition(c, t)
+			}
+		}
+		c.text()
+		if !c.accept(",") {
+			return
+		}
+	}
+}
+
+// resolve checks the key and index columns of the tables and spells them
+// as the columns are declared, since SQL names are case insensitive. Unknown
+// primary key columns are reported, and the indexes on expressions and the
+// foreign keys declared twice are left out.
+func (s *schema) resolve() {
+	for _, t := range s.tables {
+		var primaryKey []keyPart
+		for _, p := range t.primaryKey {
+			c := t.column(p.name)
+			if c == nil {
+				s.e.Errorf(p.pos, p.pos+len([]rune(p.name)), "primary key references undefined column %q of table %q", p.name, t.name)
+				continue
+			}
+			c.isPrimaryKey = true
+			primaryKey = append(primaryKey, keyPart{name: c.name, pos: p.pos})
+		}
+		t.primaryKey = primaryKey
+
+		var indexes []*index
+		for _, idx := range t.indexes {
+			if hasExpr(idx.columns) {
+				s.e.Warnf(idx.pos, idx.pos+len([]rune(idx.name)), "index %q on an expression is left out", idx.name)
+				continue
+			}
+			spell(t, idx.columns)
+			indexes = append(indexes, idx)
+		}
+		t.indexes = indexes
+	}
+
+	for _, t := range s.tables {
+		seen := map[string]bool{}
+		var foreignKeys []*foreignKey
+		for _, fk := range t.foreignKeys {
+			spell(t, fk.columns)
+			for _, p := range fk.columns {
+				if c := t.column(p.name); c != nil {
+					c.isForeignKey = true
+				}
+			}
+			if ref := s.table(fk.refTable); ref != nil {
+				fk.refTable = ref.name
+				if len(fk.refColumns) == 0 {
+					fk.refColumns = ref.primaryKey
+				}
+				spell(ref, fk.refColumns)
+			}
+			key := fk.refTable + "(" + strings.Join(names(fk.refColumns), ",") + ") " + strings.Join(names(fk.columns), ",")
+			if seen[key] {
+				continue
+			}
+			seen[key] = true
+			foreignKeys = append(foreignKeys, fk)
+		}
+		t.foreignKeys = foreignKeys
+	}
+}
+
+// spell replaces the names of parts with those of the columns of t they
+// refer to.
+func spell(t *table, parts []keyPart) {
+	for i, p := range parts {
+		if c := t.column(p.name); c != nil {
+			parts[i].name = c.name
+		}
+	}
+}
+
+func hasExpr(parts []keyPart) bool {
+	for _, p := range parts {
+		if p.expr {
+			return true
+		}
+	}
+	return false
+}
+
+// build turns the collected tables into the model. Foreign keys become
+// relations from the referencing table, on the "many" side, to the
+// referenced one, which is optional unless every key column is not null.
+func (s *schema) build() {
+	e := s.e
+	s.resolve()
+
+	for _, t := range s.tables {
+		table := e.NewTable(t.name, nil, t.pos)
+		for _, c := range t.columns {
+			table.AppendColumn(erd.Column{
+				Title:        c.name,
+				Type:         c.typ,
+				IsNotNull:    c.notNull && !c.isPrimaryKey,
+				Default:      c.def,
+				IsPrimaryKey: c.isPrimaryKey,
+				IsForeignKey: c.isForeignKey,
+				Pos:          c.pos,
+			})
+		}
+		for _, idx := range t.indexes {
+			var columnPos []int
+			for _, p := range idx.columns {
+				columnPos = append(columnPos, p.pos)
+			}
+			table.AppendIndex(erd.Index{Title: idx.name, Columns: names(idx.columns), IsUnique: idx.unique, Pos: idx.pos}, columnPos)
+		}
+	}
+
+	for _, t := range s.tables {
+		for _, fk := range t.foreignKeys {
+			r := erd.Relation{
+				LeftTableName:    t.name,
+				LeftCardinality:  "*",
+				RightTableName:   fk.refTable,
+				RightCardinality: "1",
+				LeftPos:          fk.pos,
+				RightPos:         fk.pos,
+			}
+			if len(fk.columns) == 1 {
+				r.LeftColumn = fk.columns[0].name
+			}
+			if len(fk.refColumns) == 1 {
+				r.RightColumn = fk.refColumns[0].name
+			}
+			for _, p := range fk.columns {
+				if c := t.column(p.name); c != nil && !c.notNull && !c.isPrimaryKey {
+					r.RightCardinality = "0"
+				}
+			}
+			e.Relations = append(e.Relations, r)
+		}
+	}
+}
@@ -0,0 +1,186 @@
+package sql
+
+import (
+	"io/ioutil"
+	"reflect"
+	"testing"
+)
+
+func TestParse(t *testing.T) {
+	src, err := ioutil.ReadFile("testdata/schema.sql")
+	if err != nil {
+		t.Fatal(err)
+	}
+	e, err := Parse("schema.sql", string(src))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	var tables []string
+	for _, table := range e.Tables {
+		tables = append(tables, table.Title)
+	}
+	if want := []string{"location", "person", "visit"}; !reflect.DeepEqual(tables, want) {
+		t.Errorf("tables = %v, want %v", tables, want)
+	}
+
+	person := e.Table("person")
+	type column struct {
+		Title, Type, Default  string
+		IsNotNull, IsPK, IsFK bool
+	}
+	var columns []column
+	for _, c := range person.Columns {
+		columns = append(columns, column{c.Title, c.Type, c.Default, c.IsNotNull, c.IsPrimaryKey, c.IsForeignKey})
+	}
+	wantColumns := []column{
+		{"name", "varchar(64)", "", false, true, false},
+		{"height", "numeric(5,2)", "", false, false, false},
+		{"birth_location_id", "integer", "", false, false, true},
+		{"created_at", "timestamp with time zone", "now()", true, false, false},
+	}
+	if !reflect.DeepEqual(columns, wantColumns) {
+		t.Errorf("person columns = %+v, want %+v", columns, wantColumns)
+	}
+
+	var indexes []string
+	for _, table := range e.Tables {
+		for _, idx := range table.Indexes {
+			indexes = append(indexes, idx.Title)
+			if unique := idx.Title != "visit_loc"; idx.IsUnique != unique {
+				t.Errorf("index %s: IsUnique = %v, want %v", idx.Title, idx.IsUnique, unique)
+			}
+		}
+	}
+	if want := []string{"person_height_name_key", "visit_loc"}; !reflect.DeepEqual(indexes, want) {
+		t.Errorf("indexes = %v, want %v", indexes, want)
+	}
+	var diags []string
+	for _, d := range e.Diagnostics {
+		diags = append(diags, d.Error())
+	}
+	if want := []string{`schema.sql:29:21: warning: index "person_lower_name" on an expression is left out`}; !reflect.DeepEqual(diags, want) {
+		t.Errorf("diagnostics = %q, want %q", diags, want)
+	}
+
+	if pks := e.Table("location").PrimaryKeys; !reflect.DeepEqual(pks, []int{0}) {
+		t.Errorf("location primary keys = %v, want [0]", pks)
+	}
+
+	var relations []string
+	for _, r := range e.Relations {
+		relations = append(relations, r.LeftTableName+"."+r.LeftColumn+" "+r.LeftCardinality+"--"+r.RightCardinality+" "+r.RightTableName+"."+r.RightColumn)
+	}
+	wantRelations := []string{
+		"person.birth_location_id *--0 location.id",
+		"visit.person_name *--1 person.name",
+		"visit.location_id *--1 location.id",
+	}
+	if !reflect.DeepEqual(relations, wantRelations) {
+		t.Errorf("relations = %q, want %q", relations, wantRelations)
+	}
+}
+
+func TestParse_errors(t *testing.T) {
+	e, err := Parse("bad.sql", "CREATE TABLE a (id int REFERENCES b);\nCREATE TABLE (x int);\nCREATE INDEX i ON c (x);\n")
+	if err == nil {
+		t.Fatal("Parse succeeded, want errors")
+	}
+	var got []string
+	for _, d := range e.Diagnostics {
+		got = append(got, d.Error())
+	}
+	want := []string{
+		`bad.sql:1:17: error: relation references undefined table "b"`,
+		`bad.sql:2:14: error: expected a name`,
+		`bad.sql:3:19: error: index on undefined table "c"`,
+	}
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("diagnostics = %q, want %q", got, want)
+	}
+
+	for _, test := range []struct {
+		src  string
+		want []string
+	}{
+		{`CREATE TABLE "`, []string{
+			`bad.sql:1:14: error: unterminated quoted identifier`,
+			`bad.sql:1:15: error: expected (`,
+		}},
+		{"CREATE TABLE t (id int);\nCREATE INDEX i ON t (\"", []string{
+			`bad.sql:2:22: error: unterminated quoted identifier`,
+			`bad.sql:2:23: error: expected ,`,
+		}},
+		{`CREATE TABLE "abc`, []string{
+			`bad.sql:1:14: error: unterminated quoted identifier`,
+			`bad.sql:1:18: error: expected (`,
+		}},
+		{"CREATE TABLE t (", []string{
+			`bad.sql:1:17: error: expected )`,
+		}},
+		{"CREATE TABLE t (id int, PRIMARY KEY (zz));", []string{
+			`bad.sql:1:38: error: primary key references undefined column "zz" of table "t"`,
+		}},
+		{"CREATE TABLE t (s text DEFAULT 'x", []string{
+			`bad.sql:1:32: error: unterminated string`,
+			`bad.sql:1:34: error: expected ,`,
+		}},
+	} {
+		e, err := Parse("bad.sql", test.src)
+		if err == nil {
+			t.Errorf("%q: Parse succeeded, want errors", test.src)
+			continue
+		}
+		var got []string
+		for _, d := range e.Diagnostics {
+			got = append(got, d.Error())
+		}
+		if !reflect.DeepEqual(got, test.want) {
+			t.Errorf("%q: diagnostics = %q, want %q", test.src, got, test.want)
+		}
+	}
+}
+
+func TestParse_keys(t *testing.T) {
+	e, err := Parse("keys.sql", `CREATE TABLE Orgs (Id int PRIMARY KEY);
+CREATE TABLE users (
+    id int PRIMARY KEY,
+    org_id int REFERENCES orgs(id),
+    FOREIGN KEY (ORG_ID) REFERENCES ORGS (ID)
+);
+CREATE INDEX i ON users (ORG_ID);
+`)
+	if err != nil {
+		t.Fatal(err)
+	}
+	var relations []string
+	for _, r := range e.Relations {
+		relations = append(relations, r.LeftTableName+"."+r.LeftColumn+" "+r.LeftCardinality+"--"+r.RightCardinality+" "+r.RightTableName+"."+r.RightColumn)
+	}
+	if want := []string{"users.org_id *--0 Orgs.Id"}; !reflect.DeepEqual(relations, want) {
+		t.Errorf("relations = %q, want %q", relations, want)
+	}
+	if idx := e.Table("users").Indexes; len(idx) != 1 || !reflect.DeepEqual(idx[0].Columns, []string{"org_id"}) {
+		t.Errorf("indexes = %+v, want i on org_id", idx)
+	}
+}
+
+func TestToken_name(t *testing.T) {
+	for _, test := range []struct {
+		src, want string
+	}{
+		{`"order ""items"""`, `order "items"`},
+		{"`a``b`", "a`b"},
+		{"[a b]", "a b"},
+		{`"abc`, "abc"},
+		{`"`, ""},
+	} {
+		tokens := lex([]rune(test.src))
+		if len(tokens) != 1 {
+			t.Fatalf("%q: %d tokens", test.src, len(tokens))
+		}
+		if got := tokens[0].name(); got != test.want {
+			t.Errorf("%q: name() = %q, want %q", test.src, got, test.want)
+		}
+	}
+}
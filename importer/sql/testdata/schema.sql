@@ -0,0 +1,30 @@
+-- pg_dump style
+CREATE TABLE public.location (
+    id integer NOT NULL,
+    city character varying(64),
+    "state" text DEFAULT 'n/a'::text,
+    CONSTRAINT location_city_check CHECK ((city <> ''))
+);
+
+CREATE TABLE public.person (
+    name varchar(64) PRIMARY KEY,
+    height numeric(5,2),
+    birth_location_id integer REFERENCES location (id) ON DELETE CASCADE,
+    created_at timestamp with time zone DEFAULT now() NOT NULL,
+    UNIQUE (height, name)
+);
+
+/* mysql style */
+CREATE TABLE IF NOT EXISTS `visit` (
+  `person_name` varchar(64) NOT NULL,
+  `location_id` int(11) unsigned NOT NULL,
+  `key` int,
+  PRIMARY KEY (`person_name`, `location_id`),
+  KEY `visit_loc` (`location_id`),
+  CONSTRAINT `fk_person` FOREIGN KEY (`person_name`) REFERENCES `person` (`name`)
+) ENGINE=InnoDB DEFAULT CHARSET=utf8;
+
+ALTER TABLE ONLY public.location ADD CONSTRAINT location_pkey PRIMARY KEY (id);
+ALTER TABLE ONLY public.visit ADD CONSTRAINT visit_loc_fk FOREIGN KEY (location_id) REFERENCES public.location(id);
+CREATE UNIQUE INDEX person_lower_name ON public.person USING btree (lower(name));
+INSERT INTO location VALUES (1, 'x');
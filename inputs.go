@@ -0,0 +1,67 @@
+package main
+
+import (
+	"fmt"
+	"io"
+	"io/ioutil"
+	"path/filepath"
+	"strings"
+
+	"github.com/kaishuu0123/erd-go/erd"
+)
+
+// inputs returns the names of the schema files to read: inputFile, the
+// --input option, and the arguments, with globs expanded. "-" stands for
+// stdin, which is also read when no file is given and stdin is not a
+// terminal.
+func inputs(inputFile string, args []string, stdinIsTerminal bool) ([]string, error) {
+	var names []string
+	if inputFile != "" {
+		names = append(names, inputFile)
+	}
+	for _, arg := range args {
+		if arg == "-" || !strings.ContainsAny(arg, "*?[") {
+			names = append(names, arg)
+			continue
+		}
+		matches, err := filepath.Glob(arg)
+		if err != nil {
+			return nil, fmt.Errorf("invalid pattern %q: %v", arg, err)
+		}
+		if len(matches) == 0 {
+			return nil, fmt.Errorf("no files match %q", arg)
+		}
+		names = append(names, matches...)
+	}
+	if len(names) == 0 && !stdinIsTerminal {
+		names = []string{"-"}
+	}
+	return names, nil
+}
+
+// readFiles reads the named files, stdin for "-". stdin is read once even
+// if "-" is given several times.
+func readFiles(names []string, stdin io.Reader) ([]erd.File, error) {
+	var files []erd.File
+	readStdin := false
+	for _, name := range names {
+		if name == "-" {
+			if readStdin {
+				continue
+			}
+			readStdin = true
+			body, err := ioutil.ReadAll(stdin)
+			if err != nil {
+				return nil, err
+			}
+			files = append(files, erd.File{Name: "<stdin>", Text: string(body)})
+			continue
+		}
+		buffer, err := ioutil.ReadFile(name)
+		if err != nil {
+			return nil, err
+		}
+		files = append(files, erd.File{Name: name, Text: string(buffer)})
+	}
+	return files, nil
+}
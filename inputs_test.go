@@ -0,0 +1,81 @@
+package main
+
+import (
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"reflect"
+	"strings"
+	"testing"
+
+	"github.com/kaishuu0123/erd-go/erd"
+)
+
+func TestInputs(t *testing.T) {
+	dir, err := ioutil.TempDir("", "erd-inputs")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(dir)
+	for _, name := range []string{"b.er", "a.er", "notes.txt"} {
+		if err := ioutil.WriteFile(filepath.Join(dir, name), nil, 0644); err != nil {
+			t.Fatal(err)
+		}
+	}
+	a, b := filepath.Join(dir, "a.er"), filepath.Join(dir, "b.er")
+
+	for _, test := range []struct {
+		input    string
+		args     []string
+		terminal bool
+		want     []string
+	}{
+		{"", nil, true, nil},
+		{"", nil, false, []string{"-"}},
+		{"", []string{"-"}, true, []string{"-"}},
+		// Files given on the command line are read instead of stdin.
+		{"", []string{a}, false, []string{a}},
+		{"main.er", []string{filepath.Join(dir, "*.er"), "-"}, false, []string{"main.er", a, b, "-"}},
+		{"main.er", nil, false, []string{"main.er"}},
+	} {
+		got, err := inputs(test.input, test.args, test.terminal)
+		if err != nil {
+			t.Errorf("inputs(%q, %q, %v): %v", test.input, test.args, test.terminal, err)
+			continue
+		}
+		if !reflect.DeepEqual(got, test.want) {
+			t.Errorf("inputs(%q, %q, %v) = %q, want %q", test.input, test.args, test.terminal, got, test.want)
+		}
+	}
+
+	for _, arg := range []string{filepath.Join(dir, "*.sql"), filepath.Join(dir, "[")} {
+		if _, err := inputs("", []string{arg}, false); err == nil {
+			t.Errorf("inputs(%q) succeeded, want an error", arg)
+		}
+	}
+}
+
+func TestReadFiles(t *testing.T) {
+	dir, err := ioutil.TempDir("", "erd-inputs")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(dir)
+	a := filepath.Join(dir, "a.er")
+	if err := ioutil.WriteFile(a, []byte("[a]\n"), 0644); err != nil {
+		t.Fatal(err)
+	}
+
+	files, err := readFiles([]string{"-", a, "-"}, strings.NewReader("[b]\n"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	want := []erd.File{{Name: "<stdin>", Text: "[b]\n"}, {Name: a, Text: "[a]\n"}}
+	if !reflect.DeepEqual(files, want) {
+		t.Errorf("got %q, want %q", files, want)
+	}
+
+	if _, err := readFiles([]string{filepath.Join(dir, "missing.er")}, strings.NewReader("")); err == nil {
+		t.Error("reading a missing file succeeded")
+	}
+}
@@ -0,0 +1,191 @@
+package diagram
+
+import (
+	"math"
+
+	"github.com/kaishuu0123/erd-go/erd"
+)
+
+// Colors of the diagram, as CSS hex colors.
+const (
+	White     = "#ffffff"
+	stroke    = "#333333"
+	grey      = "#666666"
+	lightGrey = "#999999"
+)
+
+// Anchor is the horizontal alignment of text on its x coordinate.
+type Anchor int
+
+const (
+	AnchorStart Anchor = iota
+	AnchorMiddle
+)
+
+// TextStyle describes how a text is drawn. An empty Color is black.
+type TextStyle struct {
+	Size      float64
+	Bold      bool
+	Italic    bool
+	Underline bool
+	Color     string
+	Anchor    Anchor
+}
+
+// Canvas is a surface the diagram is drawn on. Coordinates are in pixels
+// from the top left corner, and colors are the values of the bgcolor
+// attributes or CSS hex colors. The y of a text is its baseline.
+type Canvas interface {
+	// Begin and End enclose the shapes of a table or a relation, which
+	// vector formats may group. id is empty for relations.
+	Begin(class, id string)
+	End()
+
+	Rect(x, y, w, h, radius float64, fill, stroke string)
+	Line(x1, y1, x2, y2 float64, stroke string)
+	Circle(cx, cy, r float64, fill, stroke string)
+	// Curve draws a cubic Bézier curve from x1, y1 to x2, y2.
+	Curve(x1, y1, c1x, c1y, c2x, c2y, x2, y2 float64, stroke string)
+	Text(x, y float64, s string, style TextStyle)
+}
+
+// Draw draws the title of the model, then its relations and its tables on
+// top of them.
+func (l *Layout) Draw(c Canvas) {
+	c.Rect(0, 0, l.Width, l.Height, 0, White, "")
+	if title := l.e.Title.TitleAttributes["label"]; title != "" {
+		c.Text(margin, margin+HeadingFontSize, title, headStyle)
+	}
+	for _, r := range l.e.Relations {
+		from, to := l.byName[r.LeftTableName], l.byName[r.RightTableName]
+		if from == nil || to == nil {
+			continue
+		}
+		l.edge(c, r, from, to)
+	}
+	for _, n := range l.Nodes {
+		l.table(c, n)
+	}
+}
+
+// table draws a node: a box with the title in its header, then the columns
+// and the indexes. Stub tables are drawn faded, with their title only.
+func (l *Layout) table(c Canvas, n *Node) {
+	t := n.Table
+	fill, border, style := White, stroke, titleStyle
+	if t.Stub {
+		border, style = lightGrey, stubStyle
+	} else if color := t.TableAttributes["bgcolor"]; color != "" {
+		fill = color
+	}
+
+	c.Begin("table", "table-"+t.Title)
+	c.Rect(n.X, n.Y, n.W, n.H, 6, fill, border)
+	c.Text(n.X+n.W/2, n.Y+headerHeight/2+TitleFontSize/3, t.Title, style)
+
+	y := n.Y + headerHeight
+	if len(t.Columns) > 0 {
+		c.Line(n.X, y, n.X+n.W, y, stroke)
+		y += padding / 2
+		for _, col := range t.Columns {
+			base := y + rowHeight/2 + FontSize/3
+			style := columnStyle
+			style.Underline = col.IsPrimaryKey
+			style.Italic = col.IsForeignKey
+			x := n.X + n.cells[0]
+			c.Text(x, base, col.Title, style)
+			if label := col.ColumnAttributes["label"]; label != "" {
+				c.Text(x+l.measure(col.Title, style), base, " "+label, labelStyle)
+			}
+			if col.Type != "" {
+				c.Text(n.X+n.cells[1], base, col.Type, detailStyle)
+			}
+			if s := constraints(col); s != "" {
+				c.Text(n.X+n.cells[2], base, s, detailStyle)
+			}
+			y += rowHeight
+		}
+		y += padding / 2
+	}
+	if len(t.Indexes) > 0 {
+		c.Line(n.X, y, n.X+n.W, y, stroke)
+		y += padding / 2
+		for _, idx := range t.Indexes {
+			c.Text(n.X+padding, y+rowHeight/2+FontSize/3, indexText(idx), indexStyle)
+			y += rowHeight
+		}
+	}
+	c.End()
+}
+
+// edge draws a relation as a curve between the sides of the two tables that
+// face each other, with crow's foot markers for the cardinalities.
+func (l *Layout) edge(c Canvas, r erd.Relation, from, to *Node) {
+	y1, y2 := from.rowY(r.LeftColumn), to.rowY(r.RightColumn)
+
+	// d is +1 when the edge leaves a side to the right.
+	var x1, x2, d1, d2 float64
+	switch {
+	case from == to || from.Layer == to.Layer:
+		x1, d1 = from.X+from.W, 1
+		x2, d2 = to.X+to.W, 1
+	case from.X < to.X:
+		x1, d1 = from.X+from.W, 1
+		x2, d2 = to.X, -1
+	default:
+		x1, d1 = from.X, -1
+		x2, d2 = to.X+to.W, 1
+	}
+
+	reach := math.Max(40, math.Abs(x2-x1)/2)
+	if from == to && y1 == y2 {
+		y2 += rowHeight
+	}
+	c1x, c2x := x1+d1*reach, x2+d2*reach
+
+	c.Begin("relation", "")
+	c.Curve(x1, y1, c1x, y1, c2x, y2, x2, y2, stroke)
+	marker(c, x1, y1, d1, r.LeftCardinality)
+	marker(c, x2, y2, d2, r.RightCardinality)
+	if label := r.RelationAttributes["label"]; label != "" {
+		// The middle of the cubic curve.
+		mx := (x1 + 3*c1x + 3*c2x + x2) / 8
+		my := (y1 + y2) / 2
+		c.Text(mx, my-4, label, TextStyle{Size: FontSize - 1, Color: grey, Anchor: AnchorMiddle})
+	}
+	c.End()
+}
+
+// marker draws the crow's foot notation of cardinality at the point x, y on
+// the side of a table, for an edge leaving in direction d:
+//
+//	0  zero or one   -o|-
+//	1  exactly one   -||-
+//	*  zero or more  -o<
+//	+  one or more   -|<
+func marker(c Canvas, x, y, d float64, cardinality string) {
+	line := func(u1, v1, u2, v2 float64) {
+		c.Line(x+d*u1, y+v1, x+d*u2, y+v2, stroke)
+	}
+	bar := func(u float64) { line(u, -6, u, 6) }
+	circle := func(u float64) { c.Circle(x+d*u, y, 4, White, stroke) }
+	crow := func() {
+		line(12, 0, 0, -6)
+		line(12, 0, 0, 6)
+	}
+
+	switch cardinality {
+	case "0":
+		bar(6)
+		circle(15)
+	case "1":
+		bar(6)
+		bar(11)
+	case "*":
+		crow()
+		circle(17)
+	case "+":
+		crow()
+		bar(14)
+	}
+}
@@ -0,0 +1,336 @@
+// Package diagram lays out an erd model and draws it on a Canvas, so that
+// images can be produced without Graphviz. The svg and png renderers
+// implement the Canvas.
+package diagram
+
+import (
+	"sort"
+
+	"github.com/kaishuu0123/erd-go/erd"
+)
+
+// Sizes of the diagram in pixels. Font sizes are in pixels too.
+const (
+	FontSize        = 12.0
+	SmallFontSize   = 10.0
+	TitleFontSize   = 14.0
+	HeadingFontSize = 20.0
+
+	headerHeight  = 26.0
+	rowHeight     = 18.0
+	padding       = 8.0
+	cellGap       = 12.0
+	minTableWidth = 134.0
+	layerGap      = 90.0
+	tableGap      = 30.0
+	margin        = 20.0
+)
+
+// Measure returns the width of s drawn with style.
+type Measure func(s string, style TextStyle) float64
+
+// EstimateWidth measures text without a font, from the usual proportions
+// of a sans-serif face. It errs on the wide side so that text stays inside
+// its box.
+func EstimateWidth(s string, style TextStyle) float64 {
+	w := 0.0
+	for _, r := range s {
+		switch {
+		case r == 'i' || r == 'l' || r == 'j' || r == '.' || r == ',' || r == '\'' || r == '|' || r == ' ':
+			w += 0.3
+		case r == 'm' || r == 'w' || r == 'M' || r == 'W':
+			w += 0.9
+		case r >= 'A' && r <= 'Z':
+			w += 0.7
+		case r > 0x2e80:
+			w += 1.0
+		default:
+			w += 0.58
+		}
+	}
+	if style.Bold {
+		w *= 1.1
+	}
+	return w * style.Size
+}
+
+// Node is a table placed in the diagram.
+type Node struct {
+	Table *erd.Table
+	Layer int
+	X, Y  float64
+	W, H  float64
+
+	order float64 // position within the layer while ordering
+	// cells holds the x offsets of the name, type and constraint columns.
+	cells [3]float64
+}
+
+// rowY returns the y of the middle of the row of column, or of the node
+// when there is no such column.
+func (n *Node) rowY(column string) float64 {
+	for i, c := range n.Table.Columns {
+		if c.Title == column {
+			return n.Y + headerHeight + padding/2 + float64(i)*rowHeight + rowHeight/2
+		}
+	}
+	return n.Y + n.H/2
+}
+
+// Layout is the placement of the tables of a model.
+type Layout struct {
+	Nodes  []*Node
+	Width  float64
+	Height float64
+
+	e       *erd.Erd
+	measure Measure
+	byName  map[string]*Node
+	top     float64 // room above the tables for the title
+}
+
+// Node returns the node of the table with the given title, or nil.
+func (l *Layout) Node(title string) *Node {
+	return l.byName[title]
+}
+
+var (
+	columnStyle = TextStyle{Size: FontSize}
+	labelStyle  = TextStyle{Size: SmallFontSize, Italic: true, Color: lightGrey}
+	detailStyle = TextStyle{Size: SmallFontSize, Color: grey}
+	indexStyle  = TextStyle{Size: SmallFontSize, Italic: true, Color: grey}
+	titleStyle  = TextStyle{Size: TitleFontSize, Bold: true, Anchor: AnchorMiddle}
+	stubStyle   = TextStyle{Size: TitleFontSize, Italic: true, Color: grey, Anchor: AnchorMiddle}
+	headStyle   = TextStyle{Size: HeadingFontSize}
+)
+
+func constraints(c erd.Column) string {
+	s := ""
+	if c.IsNotNull {
+		s = "not null"
+	}
+	if c.Default != "" {
+		if s != "" {
+			s += " "
+		}
+		s += "default " + c.Default
+	}
+	return s
+}
+
+func indexText(idx erd.Index) string {
+	s := idx.Title + " ("
+	if idx.IsUnique {
+		s = "unique " + s
+	}
+	for i, c := range idx.Columns {
+		if i > 0 {
+			s += ", "
+		}
+		s += c
+	}
+	return s + ")"
+}
+
+// size computes the box of a table: a header with the title, a row per
+// column and a row per index.
+func (l *Layout) size(n *Node) {
+	t := n.Table
+	var name, typ, cons float64
+	for _, c := range t.Columns {
+		w := l.measure(c.Title, columnStyle)
+		if label := c.ColumnAttributes["label"]; label != "" {
+			w += l.measure(" "+label, labelStyle)
+		}
+		name = maxf(name, w)
+		typ = maxf(typ, l.measure(c.Type, detailStyle))
+		cons = maxf(cons, l.measure(constraints(c), detailStyle))
+	}
+	n.cells[0] = padding
+	n.cells[1] = n.cells[0] + name
+	if typ > 0 {
+		n.cells[1] += cellGap
+	}
+	n.cells[2] = n.cells[1] + typ
+	if cons > 0 {
+		n.cells[2] += cellGap
+	}
+	w := n.cells[2] + cons + padding
+	for _, idx := range t.Indexes {
+		w = maxf(w, l.measure(indexText(idx), indexStyle)+2*padding)
+	}
+	w = maxf(w, l.measure(t.Title, titleStyle)+2*padding)
+	n.W = maxf(w, minTableWidth)
+
+	n.H = headerHeight
+	if rows := len(t.Columns); rows > 0 {
+		n.H += float64(rows)*rowHeight + padding
+	}
+	if rows := len(t.Indexes); rows > 0 {
+		n.H += float64(rows)*rowHeight + padding
+	}
+}
+
+func maxf(a, b float64) float64 {
+	if a > b {
+		return a
+	}
+	return b
+}
+
+// New places the tables of e in layers from left to right so that the left
+// table of a relation comes before its right table, as dot does with
+// rankdir=LR. Cycles are broken by ignoring the relations that close them,
+// and the tables of a layer are ordered to keep related tables close.
+func New(e *erd.Erd, measure Measure) *Layout {
+	l := &Layout{e: e, measure: measure, byName: map[string]*Node{}}
+	for _, t := range e.Tables {
+		n := &Node{Table: t}
+		l.size(n)
+		l.Nodes = append(l.Nodes, n)
+		l.byName[t.Title] = n
+	}
+
+	// Edges of the layering, without self loops, duplicates and the
+	// relations to undefined tables.
+	succ := map[*Node][]*Node{}
+	pred := map[*Node][]*Node{}
+	seen := map[[2]*Node]bool{}
+	for _, r := range e.Relations {
+		from, to := l.byName[r.LeftTableName], l.byName[r.RightTableName]
+		if from == nil || to == nil || from == to || seen[[2]*Node{from, to}] {
+			continue
+		}
+		seen[[2]*Node{from, to}] = true
+		succ[from] = append(succ[from], to)
+		pred[to] = append(pred[to], from)
+	}
+
+	// Drop the edges that close a cycle, found by a depth first search in
+	// declaration order.
+	const (
+		unvisited = iota
+		active
+		finished
+	)
+	state := map[*Node]int{}
+	back := map[[2]*Node]bool{}
+	var visit func(n *Node)
+	visit = func(n *Node) {
+		state[n] = active
+		for _, m := range succ[n] {
+			switch state[m] {
+			case unvisited:
+				visit(m)
+			case active:
+				back[[2]*Node{n, m}] = true
+			}
+		}
+		state[n] = finished
+	}
+	for _, n := range l.Nodes {
+		if state[n] == unvisited {
+			visit(n)
+		}
+	}
+
+	// Longest path layering. Nodes are visited in declaration order until
+	// every predecessor has a layer, which terminates as the graph without
+	// back edges is acyclic.
+	layered := map[*Node]bool{}
+	for len(layered) < len(l.Nodes) {
+		for _, n := range l.Nodes {
+			if layered[n] {
+				continue
+			}
+			ready := true
+			layer := 0
+			for _, p := range pred[n] {
+				if back[[2]*Node{p, n}] {
+					continue
+				}
+				if !layered[p] {
+					ready = false
+					break
+				}
+				if p.Layer+1 > layer {
+					layer = p.Layer + 1
+				}
+			}
+			if ready {
+				n.Layer = layer
+				layered[n] = true
+			}
+		}
+	}
+
+	var layers [][]*Node
+	for _, n := range l.Nodes {
+		for len(layers) <= n.Layer {
+			layers = append(layers, nil)
+		}
+		n.order = float64(len(layers[n.Layer]))
+		layers[n.Layer] = append(layers[n.Layer], n)
+	}
+
+	// Order each layer by the mean position of the neighbours in the other
+	// layers, sweeping a few times. Ties keep declaration order.
+	for sweep := 0; sweep < 4; sweep++ {
+		for _, layer := range layers {
+			bary := map[*Node]float64{}
+			for _, n := range layer {
+				sum, count := 0.0, 0
+				for _, m := range append(append([]*Node(nil), succ[n]...), pred[n]...) {
+					if m.Layer != n.Layer {
+						sum += m.order
+						count++
+					}
+				}
+				bary[n] = n.order
+				if count > 0 {
+					bary[n] = sum / float64(count)
+				}
+			}
+			sort.SliceStable(layer, func(i, j int) bool { return bary[layer[i]] < bary[layer[j]] })
+			for i, n := range layer {
+				n.order = float64(i)
+			}
+		}
+	}
+
+	// Coordinates: layers are columns, centred vertically on the tallest.
+	var heights []float64
+	tallest := 0.0
+	for _, layer := range layers {
+		h := 0.0
+		for i, n := range layer {
+			if i > 0 {
+				h += tableGap
+			}
+			h += n.H
+		}
+		heights = append(heights, h)
+		tallest = maxf(tallest, h)
+	}
+	if title := e.Title.TitleAttributes["label"]; title != "" {
+		l.top = HeadingFontSize * 2
+		l.Width = l.measure(title, headStyle) + 2*margin
+	}
+	x := margin
+	for i, layer := range layers {
+		y := margin + l.top + (tallest-heights[i])/2
+		width := 0.0
+		for _, n := range layer {
+			n.X, n.Y = x, y
+			y += n.H + tableGap
+			width = maxf(width, n.W)
+		}
+		for _, n := range layer {
+			n.X += (width - n.W) / 2
+		}
+		x += width + layerGap
+	}
+	l.Width = maxf(l.Width, maxf(x-layerGap+margin, 2*margin))
+	l.Height = tallest + l.top + 2*margin
+	return l
+}
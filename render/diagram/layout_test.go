@@ -0,0 +1,57 @@
+package diagram
+
+import (
+	"testing"
+
+	"github.com/kaishuu0123/erd-go/erd"
+)
+
+func TestNew(t *testing.T) {
+	e, err := erd.ParseString("test.er", `title {label: "Test"}
+
+[Person]
+*id
++location_id
+
+[Location]
+*id
+city
+
+[Country]
+*code
+
+[Loner]
+*id
+
+Person *--1 Location
+Location *--1 Country
+Country 1--* Person
+Person 0--1 Person
+`)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	l := New(e, EstimateWidth)
+	layers := map[string]int{"Person": 0, "Location": 1, "Country": 2, "Loner": 0}
+	for title, layer := range layers {
+		n := l.Node(title)
+		if n == nil {
+			t.Fatalf("no node for %s", title)
+		}
+		if n.Layer != layer {
+			t.Errorf("%s: layer %d, want %d", title, n.Layer, layer)
+		}
+	}
+
+	for i, a := range l.Nodes {
+		if a.X < 0 || a.Y < 0 || a.X+a.W > l.Width || a.Y+a.H > l.Height {
+			t.Errorf("%s lies outside the diagram", a.Table.Title)
+		}
+		for _, b := range l.Nodes[i+1:] {
+			if a.X < b.X+b.W && b.X < a.X+a.W && a.Y < b.Y+b.H && b.Y < a.Y+a.H {
+				t.Errorf("%s overlaps %s", a.Table.Title, b.Table.Title)
+			}
+		}
+	}
+}
@@ -0,0 +1,32 @@
+// Package dot renders an erd model as a graph in the Graphviz dot language.
+package dot
+
+import (
+	"io"
+	"text/template"
+
+	"github.com/kaishuu0123/erd-go/erd"
+	"github.com/kaishuu0123/erd-go/render/funcs"
+)
+
+// Root is the template that Render executes.
+const Root = "dot"
+
+var templates = Templates()
+
+// Templates returns a new set of the embedded templates with the helper
+// functions of package funcs. Further templates can be parsed into it to
+// override or extend them.
+func Templates() *template.Template {
+	return template.Must(
+		template.New("").Funcs(funcs.FuncMap()).Parse(
+			string(MustAsset("templates/dot.tmpl")) +
+				string(MustAsset("templates/dot_tables.tmpl")) +
+				string(MustAsset("templates/dot_relations.tmpl")) +
+				string(MustAsset("templates/dot_groups.tmpl"))))
+}
+
+// Render writes the diagram of e to w.
+func Render(w io.Writer, e *erd.Erd) error {
+	return templates.ExecuteTemplate(w, Root, e)
+}
@@ -0,0 +1,254 @@
+package dot
+
+import (
+	"bytes"
+	"encoding/xml"
+	"flag"
+	"io"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/kaishuu0123/erd-go/erd"
+)
+
+var update = flag.Bool("update", false, "update the golden files in testdata")
+
+func TestRender(t *testing.T) {
+	e, err := erd.Parse(strings.NewReader("[Person]\n*name\n+birth_location_id\n[Location]\n*id\nPerson.birth_location_id *--1 Location.id\n"))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	var buf bytes.Buffer
+	if err := Render(&buf, e); err != nil {
+		t.Fatal(err)
+	}
+	for _, want := range []string{
+		`Person:"birth_location_id" -- Location:"id"`,
+		`<TD ALIGN="LEFT" PORT="name"><FONT POINT-SIZE="12"><U>name</U></FONT></TD>`,
+	} {
+		if !strings.Contains(buf.String(), want) {
+			t.Errorf("output does not contain %q:\n%v", want, buf.String())
+		}
+	}
+}
+
+func TestRender_golden(t *testing.T) {
+	for _, name := range []string{"simple", "nfldb"} {
+		f, err := os.Open(filepath.Join("..", "..", "examples", name+".er"))
+		if err != nil {
+			t.Fatal(err)
+		}
+		e, err := erd.Parse(f)
+		f.Close()
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		var first, second bytes.Buffer
+		if err := Render(&first, e); err != nil {
+			t.Fatal(err)
+		}
+		if err := Render(&second, e); err != nil {
+			t.Fatal(err)
+		}
+		if !bytes.Equal(first.Bytes(), second.Bytes()) {
+			t.Errorf("%s: output differs between runs", name)
+		}
+
+		golden := filepath.Join("testdata", name+".dot")
+		if *update {
+			if err := ioutil.WriteFile(golden, first.Bytes(), 0644); err != nil {
+				t.Fatal(err)
+			}
+		}
+		want, err := ioutil.ReadFile(golden)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if !bytes.Equal(first.Bytes(), want) {
+			t.Errorf("%s: output differs from %s:\n%s", name, golden, first.String())
+		}
+	}
+}
+
+const hostile = `title {label: "Orders & <Items>"}
+
+[order-item] {bgcolor: "#ececfc"}
+*id
+amount numeric(10,2) not null default 'a<b' {label: "array<int> & more"}
++ürün_id
+index by<amount> (amount) {unique: true}
+
+[ürün]
+*id
+name varchar {label: it's}
+
+[node]
+*a&b
+<c>
+
+[public.user]
+id
+
+order-item.ürün_id *--1 ürün.id {label: "<has> & 'owns'"}
+node.a&b 0--+ order-item
+order-item *--1 "public.user".id
+`
+
+func TestRender_escaping(t *testing.T) {
+	e, err := erd.ParseString("hostile.er", hostile)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	var buf bytes.Buffer
+	if err := Render(&buf, e); err != nil {
+		t.Fatal(err)
+	}
+	out := buf.String()
+
+	for _, want := range []string{
+		`label=<<FONT POINT-SIZE="20">Orders &amp; &lt;Items&gt;</FONT>>`,
+		`  "order-item" [label=<`,
+		`  "ürün" [label=<`,
+		`  "node" [label=<`,
+		`  "public.user" [label=<`,
+		`<B>order-item</B>`,
+		`unique by&lt;amount&gt; (amount)`,
+		`PORT="a&amp;b"`,
+		`PORT="&lt;c&gt;"`,
+		`&nbsp;array&lt;int&gt; &amp; more</FONT>`,
+		`default &#39;a&lt;b&#39;`,
+		`&nbsp;it&#39;s</FONT>`,
+		`"order-item":"ürün_id" -- "ürün":"id"`,
+		`"node":"a&b" -- "order-item"`,
+		`"order-item" -- "public.user":"id"`,
+		`label=<<FONT>&lt;has&gt; &amp; &#39;owns&#39;</FONT>>`,
+	} {
+		if !strings.Contains(out, want) {
+			t.Errorf("output does not contain %s:\n%s", want, out)
+		}
+	}
+
+	// Every HTML-like label must be well formed once its delimiters are
+	// balanced, which only holds when the text inside is escaped.
+	labels := htmlLabels(out)
+	if len(labels) == 0 {
+		t.Fatal("no HTML-like labels found")
+	}
+	for _, label := range labels {
+		d := xml.NewDecoder(strings.NewReader("<label>" + label + "</label>"))
+		d.Entity = xml.HTMLEntity
+		for {
+			_, err := d.Token()
+			if err == io.EOF {
+				break
+			}
+			if err != nil {
+				t.Errorf("malformed label %s: %v", label, err)
+				break
+			}
+		}
+	}
+}
+
+// htmlLabels returns the contents of the <...> values in a dot graph.
+func htmlLabels(dot string) []string {
+	var labels []string
+	for i := 0; i < len(dot); i++ {
+		if dot[i] != '=' || i+1 >= len(dot) || dot[i+1] != '<' {
+			continue
+		}
+		depth := 0
+		for j := i + 1; j < len(dot); j++ {
+			switch dot[j] {
+			case '<':
+				depth++
+			case '>':
+				depth--
+			}
+			if depth == 0 {
+				labels = append(labels, dot[i+2:j])
+				i = j
+				break
+			}
+		}
+	}
+	return labels
+}
+
+func TestRender_groups(t *testing.T) {
+	e, err := erd.ParseString("test.er", `group "Billing" {label: "Billing & co", bgcolor: "#ececfc", color: grey}
+
+[invoice] {group: Billing}
+*id
+
+[payment] {group: Billing}
+*id
+
+[user-account] {group: Accounts}
+*id
+
+[meta]
+x
+`)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	var buf bytes.Buffer
+	if err := Render(&buf, e); err != nil {
+		t.Fatal(err)
+	}
+	for _, want := range []string{
+		`  subgraph cluster_0 {
+    label=<<FONT POINT-SIZE="16">Billing &amp; co</FONT>>;
+    labeljust=l;
+    style="rounded,filled";
+    fillcolor="#ececfc";
+    color="grey";
+    invoice; payment;
+  }`,
+		`  subgraph cluster_1 {
+    label=<<FONT POINT-SIZE="16">Accounts</FONT>>;
+    labeljust=l;
+    style=rounded;
+    "user-account";
+  }`,
+	} {
+		if !strings.Contains(buf.String(), want) {
+			t.Errorf("output does not contain %s:\n%s", want, buf.String())
+		}
+	}
+	if strings.Contains(buf.String(), "meta;") {
+		t.Errorf("meta is in a cluster:\n%s", buf.String())
+	}
+}
+
+func TestRender_stub(t *testing.T) {
+	e, err := erd.ParseString("test.er", `[team] {stub: false}
+*id
+
+[player]
+*id
+`)
+	if err != nil {
+		t.Fatal(err)
+	}
+	e.Table("player").Stub = true
+
+	var buf bytes.Buffer
+	if err := Render(&buf, e); err != nil {
+		t.Fatal(err)
+	}
+	if want := `player [label=<<FONT POINT-SIZE="14" FACE="Helvetica italic" COLOR="grey40">player</FONT>>`; !strings.Contains(buf.String(), want) {
+		t.Errorf("player is not drawn as a stub:\n%s", buf.String())
+	}
+	if strings.Contains(buf.String(), `COLOR="grey40">team<`) {
+		t.Errorf("team is drawn as a stub:\n%s", buf.String())
+	}
+}
@@ -0,0 +1,306 @@
+// Code generated by go-bindata.
+// sources:
+// templates/dot.tmpl
+// templates/dot_groups.tmpl
+// templates/dot_relations.tmpl
+// templates/dot_tables.tmpl
+// DO NOT EDIT!
+
+package dot
+
+import (
+	"bytes"
+	"compress/gzip"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"strings"
+	"time"
+)
+
+func bindataRead(data []byte, name string) ([]byte, error) {
+	gz, err := gzip.NewReader(bytes.NewBuffer(data))
+	if err != nil {
+		return nil, fmt.Errorf("Read %q: %v", name, err)
+	}
+
+	var buf bytes.Buffer
+	_, err = io.Copy(&buf, gz)
+	clErr := gz.Close()
+
+	if err != nil {
+		return nil, fmt.Errorf("Read %q: %v", name, err)
+	}
+	if clErr != nil {
+		return nil, err
+	}
+
+	return buf.Bytes(), nil
+}
+
+type asset struct {
+	bytes []byte
+	info  os.FileInfo
+}
+
+type bindataFileInfo struct {
+	name    string
+	size    int64
+	mode    os.FileMode
+	modTime time.Time
+}
+
+func (fi bindataFileInfo) Name() string {
+	return fi.name
+}
+func (fi bindataFileInfo) Size() int64 {
+	return fi.size
+}
+func (fi bindataFileInfo) Mode() os.FileMode {
+	return fi.mode
+}
+func (fi bindataFileInfo) ModTime() time.Time {
+	return fi.modTime
+}
+func (fi bindataFileInfo) IsDir() bool {
+	return false
+}
+func (fi bindataFileInfo) Sys() interface{} {
+	return nil
+}
+
+var _templatesDotTmpl = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\x84\x92\xdf\x8b\xd4\x30\x10\xc7\xdf\xf7\xaf\x08\x79\xde\x8d\xd9\xd5\x43\xc5\xcd\x82\x0f\x8a\x07\x77\x7b\xa2\x7d\xf2\x07\x92\x36\xb3\x6d\x34\x97\x84\x64\xca\x81\x21\xff\xbb\x34\xf5\x6c\x3c\x17\x0f\x4a\x19\x3e\x33\xdf\xc9\x27\xa5\x29\x6d\x88\x82\x93\xb6\x40\xa8\x72\x48\xc9\x26\xe7\x55\x1f\xa4\x1f\x48\x5a\x11\x42\xc8\x5c\x7f\x2e\xf5\xf4\x4c\x01\x7d\x22\xac\xd1\x68\x60\x7e\xbf\x46\x0c\xba\x1d\x11\x22\x33\xb2\x05\x53\x76\xdc\xcf\x17\x22\xf6\xfb\xb7\x37\xc7\x86\xbc\xbf\xb9\x3c\x36\x9b\x8f\x97\x9f\xde\x08\xba\xe3\xf4\x90\x12\xc4\x4e\x7a\x78\xd7\x5c\x5f\xfd\x77\x65\xce\xfb\x27\xd3\x86\xc3\x61\xfd\xc7\xa4\x34\xbe\x8f\x11\x85\x79\x00\x8d\xeb\x04\x2e\x6c\x52\x06\xab\xfe\xd2\xb2\x4e\x41\x04\x2f\x38\xbb\x58\x06\x83\xb4\x3f\xfe\x81\x5e\x2a\x41\x39\xdb\xad\x39\xdb\xd1\x05\xdf\xca\xd0\x6b\x3b\x75\x78\x45\x3b\xb0\x1d\x58\x0c\x12\x41\x60\x18\x61\xe9\x44\x6f\xb4\x85\x28\xe8\x5c\x54\x99\xe9\x54\xa5\x83\xb8\xfa\x50\xd0\xd7\x57\xab\x7b\xc1\xea\xb3\x97\x7b\x09\xfa\xe5\x58\x05\x4f\xce\x62\xd4\x3f\x41\x6c\x9f\x9d\xf5\x7a\xbe\xe6\x8c\x5f\x54\x01\x0f\xf6\x4e\x2b\x1c\xc4\x96\xf1\x85\xc6\x41\x7a\x10\xd7\x01\x3a\x17\x54\xad\x00\xaa\xaf\x15\x26\xc7\xd6\xe1\x70\x4e\x60\xb7\x40\x19\x82\xbb\x2b\x94\xb3\x97\x8f\x9d\x5d\xae\x25\x6d\x6f\x40\x3c\xad\x76\x14\xac\x74\x44\x69\x3b\x10\x5b\xf6\xa2\xd6\x4a\x09\xe1\xd6\x1b\x89\xf3\x2f\xfb\x2d\x80\x91\xa8\x9d\x8d\x94\xb0\x9c\xcf\x8e\xa0\x6c\x0d\xcc\xfd\x87\xad\x3e\xb8\xd1\xff\x8e\xe6\x55\x4a\x60\x55\xce\xbf\x06\x00\x24\x02\x6f\x12\x16\x03\x00\x00")
+
+func templatesDotTmplBytes() ([]byte, error) {
+	return bindataRead(
+		_templatesDotTmpl,
+		"templates/dot.tmpl",
+	)
+}
+
+func templatesDotTmpl() (*asset, error) {
+	bytes, err := templatesDotTmplBytes()
+	if err != nil {
+		return nil, err
+	}
+
+	info := bindataFileInfo{name: "templates/dot.tmpl", size: 790, mode: os.FileMode(420), modTime: time.Unix(1792137964, 0)}
+	a := &asset{bytes: bytes, info: info}
+	return a, nil
+}
+
+var _templatesDot_groupsTmpl = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\x8c\x92\xcb\x4b\xc3\x40\x10\xc6\xef\xf9\x2b\x86\x25\xc7\xb6\xe2\xc5\x83\x6d\x0a\x82\xaf\x82\xb6\x8a\x39\x79\x29\x49\x77\x9a\x6e\x59\x76\xcb\x3e\x50\x19\xe6\x7f\x97\xcd\x46\x5a\x3d\xa8\xc7\x79\xe4\xf7\x7d\xf9\x66\x89\x24\x6e\x95\x41\x10\xd2\x86\x75\xe7\x6c\x3c\x78\xc1\x5c\x10\x8d\xc1\x35\xa6\x43\x28\xd5\x08\xca\x0e\x2e\x2b\x98\xdc\xf5\x63\xe6\x02\xc0\xc7\xb6\x73\xcd\x61\x07\x1b\x1d\x7d\x40\xb7\x26\x2a\x15\x33\x50\x01\x00\xa0\x9b\x16\x75\x35\x9b\xdd\xae\x96\x35\x3c\xad\x16\xcb\x7a\xfc\xb2\x78\xbd\xa9\xc4\xf9\x85\x98\x13\xbd\xa9\xb0\x03\x65\x24\xbe\x0f\xcc\xab\x10\x9c\x6a\x63\x40\x0f\xa2\xff\x56\x30\x13\xa1\xdf\x34\x07\xbc\xaf\x1f\x1f\x60\xd2\xd7\xda\xe3\x8f\x7e\xd9\x4d\x6a\x15\x74\x6e\x1b\xc9\x3c\x3b\x4b\x9a\xf3\xf9\xf4\xe8\x63\x1f\x7d\xa8\x74\x6e\xa4\xdf\xfa\x55\xbd\xed\x36\x56\x5b\x97\x22\x48\xfb\x3e\x7c\x68\xac\x84\xb3\xd1\x48\x94\xa3\xad\xd2\x1a\xa5\xc8\xac\x54\xf4\xcb\x15\x91\xb4\xe1\x39\xda\x80\xc9\xe8\x51\x29\x1b\x3e\x01\x0d\x9c\x93\x0d\x23\x99\xff\xe7\xec\x9b\xaf\x3f\x74\x4f\xa8\xc3\x11\xf7\x23\x28\x43\x7f\xc4\xba\x69\x35\xfa\x14\x98\xda\x42\xb9\x4f\x37\xcb\xd1\xf5\xb4\xc5\x35\x94\xe1\x2b\xd3\xe9\x30\x29\x00\xf2\x8b\xc8\x15\xd1\x18\xd0\x48\xe6\xe2\x73\x00\x30\xbb\xde\xf1\x3e\x02\x00\x00")
+
+func templatesDot_groupsTmplBytes() ([]byte, error) {
+	return bindataRead(
+		_templatesDot_groupsTmpl,
+		"templates/dot_groups.tmpl",
+	)
+}
+
+func templatesDot_groupsTmpl() (*asset, error) {
+	bytes, err := templatesDot_groupsTmplBytes()
+	if err != nil {
+		return nil, err
+	}
+
+	info := bindataFileInfo{name: "templates/dot_groups.tmpl", size: 574, mode: os.FileMode(420), modTime: time.Unix(1792137963, 0)}
+	a := &asset{bytes: bytes, info: info}
+	return a, nil
+}
+
+var _templatesDot_relationsTmpl = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\x8c\x92\xd1\x4f\xc2\x30\x10\xc6\xdf\xf7\x57\x5c\xf6\x24\xca\xa6\xbc\x2a\x90\x18\x8d\xd1\x04\x31\x12\xde\x8c\x31\x85\xde\xa0\x49\x69\xb5\x2b\x21\xe6\x72\xff\xbb\x59\x85\x21\x74\x23\xbe\x5d\xee\x6e\xbf\xef\xdb\x7d\x25\x92\x58\x28\x83\x90\x4a\xeb\x3f\x1c\x6a\xe1\x95\x35\x65\xca\x9c\x10\x39\x61\x16\x08\xf9\x64\xd7\x65\x4e\x00\x88\xa4\xf5\x4f\xf7\x90\x8f\xb0\xf0\x53\x31\xd3\x38\x16\x2b\x64\x26\x52\xc5\x6f\xf3\xce\xea\xf5\xca\x30\x5f\x87\xd5\xd7\xb5\xf5\x78\x38\x20\x42\x23\x99\x21\xcb\xf6\xb4\x89\x5a\x2c\x63\x5c\xe8\x36\xf2\x0e\x26\x3b\xe0\x5b\x02\x50\x39\xcc\x40\x15\x70\x86\x5f\xbb\x35\xe1\xa4\x32\x42\x2b\xff\x0d\xe9\x79\xda\x81\x2c\xfc\x09\x80\x70\xce\x6e\x96\x28\xe4\xc0\xce\x9d\xdd\x74\xab\x52\x8b\x19\xea\x41\xbf\xff\xf0\x32\x9e\x0e\xaf\xf2\x7c\xdc\xbf\x0c\xe5\xb0\x5b\xc3\x51\x97\x78\x42\xe1\x22\xed\x34\xf3\x3d\x62\x2c\xd1\x6b\x97\x88\x6d\x1a\x6b\xd0\x36\x62\x88\x22\x27\xcc\x0d\x5c\x23\x6b\xec\xf6\x4e\x75\xbe\xb7\xde\x3b\x35\x5b\x7b\x2c\xf3\x80\xae\x17\x8f\x84\xb0\x9c\x8b\x4f\x7c\x9c\x3e\x8f\xda\xbf\xfd\xa7\x76\xb8\x60\x78\x1a\x27\x23\xf2\x42\xe9\x6d\x44\x55\xd9\x1a\x51\x73\x42\x11\xff\x28\xa0\x3d\xbd\xba\x6c\x24\xd0\x6b\x15\x88\x3d\xd6\xf9\x44\x14\xa2\x63\x1f\xcc\x31\xb5\xbe\xd0\xfb\x4d\xf2\xb7\x41\x94\x01\x1a\x09\x19\xf3\xcf\x00\xc1\xcf\x7e\x59\xb2\x03\x00\x00")
+
+func templatesDot_relationsTmplBytes() ([]byte, error) {
+	return bindataRead(
+		_templatesDot_relationsTmpl,
+		"templates/dot_relations.tmpl",
+	)
+}
+
+func templatesDot_relationsTmpl() (*asset, error) {
+	bytes, err := templatesDot_relationsTmplBytes()
+	if err != nil {
+		return nil, err
+	}
+
+	info := bindataFileInfo{name: "templates/dot_relations.tmpl", size: 946, mode: os.FileMode(420), modTime: time.Unix(1792137759, 0)}
+	a := &asset{bytes: bytes, info: info}
+	return a, nil
+}
+
+var _templatesDot_tablesTmpl = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\xcc\x56\x6d\x6f\xda\x3c\x14\xfd\xce\xaf\xb8\xb2\xd0\xa3\x67\x12\x6f\xdd\x58\x3f\xac\x49\x24\x20\xa1\x44\xa3\x84\x51\x77\x93\x36\x4d\x53\x20\x86\x59\x73\x9d\x2e\x71\xa6\xa1\xcc\xff\x7d\x8a\xe3\xf0\x12\x92\xd2\xf6\xd3\x24\x44\x9c\x6b\xdf\xe3\xe3\xe3\xe3\x1b\xa7\x69\x40\xd6\x94\x13\x40\x41\x28\xbe\x09\x7f\xc9\x48\x8c\xa4\x6c\xa4\x69\xe4\xf3\x0d\x81\xa6\x80\x77\x26\x74\xb0\xea\x50\xf1\x36\xd0\x35\x74\x6e\x45\xb2\x94\xb2\x01\x90\xa6\x41\x28\x5c\x1b\x3a\x98\x0a\x46\xa4\x84\x2f\xcc\x5f\x12\x66\x1a\xc6\xd8\x9b\x61\x98\x7b\xee\x0c\xb7\x6f\xdd\xcf\x8e\x89\x2e\xfa\x08\xc6\x83\x91\x63\xa2\x09\x61\xbf\x88\xa0\x2b\x1f\xa8\xf0\x19\x5d\x21\x18\x79\x53\x6f\x61\xa2\x4d\x44\xb6\xfd\x1e\xb2\xd2\x94\xc4\x2b\xff\x81\x4c\xf0\xcd\x74\x07\x6d\x74\x33\x48\xcb\x6a\x35\x00\x00\x62\xb1\x65\xc4\x44\x51\x98\xf0\x80\x04\xad\xc0\x8f\xbf\x93\x00\xe5\x7d\xab\x90\x85\x51\x8e\x76\xd9\x43\x2a\xf4\xf5\x4a\x71\x27\x2c\x26\x67\x78\xe3\xc1\x70\xea\xa8\x1c\x80\xa1\xb7\xb0\x9d\x85\x89\x34\x08\xc0\xc8\x99\x4e\xe7\x03\xdb\x76\x67\xd7\xa5\xe8\xed\x7c\x30\xca\xa3\x9d\xb7\x45\xfc\x93\x6b\xe3\x89\x89\x2e\xde\xf4\x8b\xc8\x60\xea\x5e\xcf\x4c\x34\x72\x66\xd8\x59\x14\x41\x4b\x3f\x0d\xbc\x28\x9a\x00\x06\xb6\x4b\xa3\xe1\xa3\x7e\x1f\x7a\x18\x7b\x37\xe8\x10\xde\x7a\x9a\xdc\xcb\x90\x05\xc8\x32\x86\x75\x02\x0f\x2d\x2d\xb2\xd1\xc5\xf6\x8e\x56\xb7\xe0\x65\x74\x95\x38\xf9\x4b\x61\x85\x51\xc8\x92\x7b\x1e\x43\x5b\xe9\x0a\xf0\x47\xfd\x3f\x2e\xa3\x5e\xc8\xd4\x19\xe3\x67\x28\xdb\xaf\xd0\xb5\x20\x99\xb1\xd1\x96\xfd\xd1\x82\xe6\x4a\xd9\x56\x53\x93\xf2\x8c\xc0\x8a\x07\xcc\xbd\x05\x36\x51\xa5\x32\x95\xfa\xbe\xde\x4d\x7e\x28\x87\x1b\xcf\x23\x7a\xef\x47\xdb\xf7\x64\x2b\xa5\x71\x97\x49\xcd\x03\x29\x2b\x87\x8e\xc3\x88\xd0\x0d\xcf\x87\xba\x35\x43\x2b\xf8\x9c\x07\xeb\xd6\xa1\x55\x70\xec\x6a\x92\xbb\x2d\xcc\x7e\xda\x08\x8d\x72\x6e\xae\xe9\x40\x88\x88\x2e\x13\x41\xe2\x8e\x3a\xef\x47\xa9\x00\xb9\x5a\xb9\xfb\x06\x11\xf5\x19\xb8\xfa\xa0\x1f\x29\xd8\x3b\x3a\xf8\x97\x3d\x64\xfd\xc7\x97\xf1\xc3\xd5\xf1\x1e\x54\xcf\x28\x65\x15\xc3\xd3\x55\x60\xfb\x64\x0d\x4d\xd1\x99\xf8\x71\x0e\x8b\xb7\x0f\x24\x96\xb2\xd6\x15\xfb\xe4\xbd\x04\x59\x8e\x94\x15\x8e\xe8\x9d\x2b\x64\x79\x62\xae\x6c\x9a\x9e\x27\x7b\xbc\x81\x15\xf4\x47\x21\x8f\x45\xe4\x53\x2e\x9e\xb9\x88\x30\xca\x6c\x30\x0b\xc5\x2c\x61\x0c\x3a\x36\x59\xfb\x09\x13\x4f\x5c\xd5\x29\xdc\x1e\x4b\x4a\x1e\x0a\xe0\x09\x63\xb5\xfe\xf3\x79\x50\x3d\x39\xd4\x5b\x76\x37\x26\xc8\x1b\x70\xac\xec\xae\xfb\x54\x55\x80\x17\x0a\xbe\xaf\x7b\xe5\xbe\x93\x3a\x78\x08\x5c\x30\x76\x79\x40\x7e\x93\x7f\xa7\x2e\x16\x84\x9e\x56\x0d\xad\x17\x1d\xe1\x5a\x73\xdc\x71\xfa\x33\x21\x52\x26\xea\x59\xec\x73\xe9\x74\xe8\x2f\xf1\xff\x25\x04\x5d\xd6\xe9\x69\x59\x4f\xd3\xec\x38\x50\x29\x5b\x95\x88\xcd\x55\xb5\x1f\x5e\x69\x43\x1c\x6e\xff\xf3\x37\x7b\x1f\xa0\x6b\x7d\x43\x3a\xa8\x51\xcb\x8d\xba\x84\x68\x8c\xd6\x9a\x32\xa6\x02\xa6\xba\x30\x7d\x48\x42\x41\x1e\x49\x3a\xbc\xe2\x64\xa9\x24\x28\xcf\x7e\x74\xa9\xc9\x56\x5e\xb4\xa0\x5d\x6a\xff\x1d\x00\x4e\xa3\x71\x38\xe3\x09\x00\x00")
+
+func templatesDot_tablesTmplBytes() ([]byte, error) {
+	return bindataRead(
+		_templatesDot_tablesTmpl,
+		"templates/dot_tables.tmpl",
+	)
+}
+
+func templatesDot_tablesTmpl() (*asset, error) {
+	bytes, err := templatesDot_tablesTmplBytes()
+	if err != nil {
+		return nil, err
+	}
+
+	info := bindataFileInfo{name: "templates/dot_tables.tmpl", size: 2531, mode: os.FileMode(420), modTime: time.Unix(1792139635, 0)}
+	a := &asset{bytes: bytes, info: info}
+	return a, nil
+}
+
+// Asset loads and returns the asset for the given name.
+// It returns an error if the asset could not be found or
+// could not be loaded.
+func Asset(name string) ([]byte, error) {
+	cannonicalName := strings.Replace(name, "\\", "/", -1)
+	if f, ok := _bindata[cannonicalName]; ok {
+		a, err := f()
+		if err != nil {
+			return nil, fmt.Errorf("Asset %s can't read by error: %v", name, err)
+		}
+		return a.bytes, nil
+	}
+	return nil, fmt.Errorf("Asset %s not found", name)
+}
+
+// MustAsset is like Asset but panics when Asset would return an error.
+// It simplifies safe initialization of global variables.
+func MustAsset(name string) []byte {
+	a, err := Asset(name)
+	if err != nil {
+		panic("asset: Asset(" + name + "): " + err.Error())
+	}
+
+	return a
+}
+
+// AssetInfo loads and returns the asset info for the given name.
+// It returns an error if the asset could not be found or
+// could not be loaded.
+func AssetInfo(name string) (os.FileInfo, error) {
+	cannonicalName := strings.Replace(name, "\\", "/", -1)
+	if f, ok := _bindata[cannonicalName]; ok {
+		a, err := f()
+		if err != nil {
+			return nil, fmt.Errorf("AssetInfo %s can't read by error: %v", name, err)
+		}
+		return a.info, nil
+	}
+	return nil, fmt.Errorf("AssetInfo %s not found", name)
+}
+
+// AssetNames returns the names of the assets.
+func AssetNames() []string {
+	names := make([]string, 0, len(_bindata))
+	for name := range _bindata {
+		names = append(names, name)
+	}
+	return names
+}
+
+// _bindata is a table, holding each asset generator, mapped to its name.
+var _bindata = map[string]func() (*asset, error){
+	"templates/dot.tmpl": templatesDotTmpl,
+	"templates/dot_groups.tmpl": templatesDot_groupsTmpl,
+	"templates/dot_relations.tmpl": templatesDot_relationsTmpl,
+	"templates/dot_tables.tmpl": templatesDot_tablesTmpl,
+}
+
+// AssetDir returns the file names below a certain
+// directory embedded in the file by go-bindata.
+// For example if you run go-bindata on data/... and data contains the
+// following hierarchy:
+//     data/
+//       foo.txt
+//       img/
+//         a.png
+//         b.png
+// then AssetDir("data") would return []string{"foo.txt", "img"}
+// AssetDir("data/img") would return []string{"a.png", "b.png"}
+// AssetDir("foo.txt") and AssetDir("notexist") would return an error
+// AssetDir("") will return []string{"data"}.
+func AssetDir(name string) ([]string, error) {
+	node := _bintree
+	if len(name) != 0 {
+		cannonicalName := strings.Replace(name, "\\", "/", -1)
+		pathList := strings.Split(cannonicalName, "/")
+		for _, p := range pathList {
+			node = node.Children[p]
+			if node == nil {
+				return nil, fmt.Errorf("Asset %s not found", name)
+			}
+		}
+	}
+	if node.Func != nil {
+		return nil, fmt.Errorf("Asset %s not found", name)
+	}
+	rv := make([]string, 0, len(node.Children))
+	for childName := range node.Children {
+		rv = append(rv, childName)
+	}
+	return rv, nil
+}
+
+type bintree struct {
+	Func     func() (*asset, error)
+	Children map[string]*bintree
+}
+var _bintree = &bintree{nil, map[string]*bintree{
+	"templates": &bintree{nil, map[string]*bintree{
+		"dot.tmpl": &bintree{templatesDotTmpl, map[string]*bintree{}},
+		"dot_groups.tmpl": &bintree{templatesDot_groupsTmpl, map[string]*bintree{}},
+		"dot_relations.tmpl": &bintree{templatesDot_relationsTmpl, map[string]*bintree{}},
+		"dot_tables.tmpl": &bintree{templatesDot_tablesTmpl, map[string]*bintree{}},
+	}},
+}}
+
+// RestoreAsset restores an asset under the given directory
+func RestoreAsset(dir, name string) error {
+	data, err := Asset(name)
+	if err != nil {
+		return err
+	}
+	info, err := AssetInfo(name)
+	if err != nil {
+		return err
+	}
+	err = os.MkdirAll(_filePath(dir, filepath.Dir(name)), os.FileMode(0755))
+	if err != nil {
+		return err
+	}
+	err = ioutil.WriteFile(_filePath(dir, name), data, info.Mode())
+	if err != nil {
+		return err
+	}
+	err = os.Chtimes(_filePath(dir, name), info.ModTime(), info.ModTime())
+	if err != nil {
+		return err
+	}
+	return nil
+}
+
+// RestoreAssets restores an asset under the given directory recursively
+func RestoreAssets(dir, name string) error {
+	children, err := AssetDir(name)
+	// File
+	if err != nil {
+		return RestoreAsset(dir, name)
+	}
+	// Dir
+	for _, child := range children {
+		err = RestoreAssets(dir, filepath.Join(name, child))
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func _filePath(dir, name string) string {
+	cannonicalName := strings.Replace(name, "\\", "/", -1)
+	return filepath.Join(append([]string{dir}, strings.Split(cannonicalName, "/")...)...)
+}
+
@@ -0,0 +1,355 @@
+// Package er writes an erd model back as the plain text schema format that
+// the erd package parses.
+package er
+
+import (
+	"bytes"
+	"fmt"
+	"io"
+	"regexp"
+	"sort"
+	"strings"
+
+	"github.com/kaishuu0123/erd-go/erd"
+	"github.com/kaishuu0123/erd-go/render/funcs"
+)
+
+var (
+	bareString = regexp.MustCompile(`^[^"\t\r\n/:,\[\]{} ]+$`)
+	bareName   = regexp.MustCompile(`^[^"\t\r\n/:,.\[\]{} ]+$`)
+	bareType   = regexp.MustCompile(`^[^"\t\r\n/:,()\[\]{} ]+(\([^)\r\n]*\))?(\[\])*$`)
+	bareValue  = regexp.MustCompile(`^('[^'\r\n]*'|[^' \t\r\n{},][^ \t\r\n{},]*)$`)
+	indexName  = regexp.MustCompile(`^[^"\t\r\n/:,()\[\]{} ]+$`)
+	// quotable matches the strings that can be written between quotes,
+	// where %q escapes the other special characters.
+	quotable = regexp.MustCompile(`^[^"]+$`)
+	keyword  = regexp.MustCompile(`^(not|null|default)(["\t\r\n/:,()\[\]{} ]|$)`)
+)
+
+// Render writes e to w in the canonical .er format: the title, the include
+// lines, the groups, then each table with its columns and indexes, then the relations,
+// separated by blank lines and without indentation. Comments are kept in
+// front of the element that followed them in the source.
+//
+// It returns an error, without writing anything, when e holds a name or a
+// value that the format has no syntax for, such as a table name with a
+// space.
+func Render(w io.Writer, e *erd.Erd) error {
+	if err := check(e); err != nil {
+		return err
+	}
+	p := &printer{ErrWriter: funcs.ErrWriter{W: w}, attached: attachComments(e)}
+
+	if len(e.Title.TitleAttributes) > 0 {
+		p.section()
+		p.comments(e.Title.Pos)
+		p.Printf("title %s\n", attributes(e.Title.TitleAttributes, nil))
+	}
+
+	if len(e.Includes) > 0 {
+		p.section()
+	}
+	for _, inc := range e.Includes {
+		p.comments(inc.Pos)
+		p.Printf("include %q\n", inc.Path)
+	}
+
+	groups := declaredGroups(e)
+	if len(groups) > 0 {
+		p.section()
+	}
+	for _, g := range groups {
+		p.comments(g.Pos)
+		p.Printf("group %q", g.Title)
+		if len(g.GroupAttributes) > 0 {
+			p.Printf(" %s", attributes(g.GroupAttributes, nil))
+		}
+		p.Printf("\n")
+	}
+
+	for _, t := range e.Tables {
+		p.section()
+		p.comments(t.Pos)
+		p.Printf("[%s]", t.Title)
+		if len(t.TableAttributes) > 0 {
+			p.Printf(" %s", attributes(t.TableAttributes, nil))
+		}
+		p.Printf("\n")
+		for _, c := range t.Columns {
+			p.comments(c.Pos)
+			p.Printf("%s\n", column(c))
+		}
+		for _, idx := range t.Indexes {
+			p.comments(idx.Pos)
+			p.Printf("index %s (%s)", idx.Title, strings.Join(idx.Columns, ", "))
+			attrs := map[string]string{}
+			for k, v := range idx.IndexAttributes {
+				attrs[k] = v
+			}
+			if idx.IsUnique {
+				attrs["unique"] = "true"
+			}
+			if len(attrs) > 0 {
+				p.Printf(" %s", attributes(attrs, nil))
+			}
+			p.Printf("\n")
+		}
+	}
+
+	if len(e.Relations) > 0 {
+		p.section()
+	}
+	for _, r := range e.Relations {
+		p.comments(r.LeftPos)
+		p.Printf("%s %s--%s %s", endpoint(r.LeftTableName, r.LeftColumn), r.LeftCardinality, r.RightCardinality, endpoint(r.RightTableName, r.RightColumn))
+		if len(r.RelationAttributes) > 0 {
+			p.Printf(" %s", attributes(r.RelationAttributes, nil))
+		}
+		p.Printf("\n")
+	}
+
+	if trailing := p.attached[-1]; len(trailing) > 0 {
+		p.section()
+		p.comments(-1)
+	}
+	return p.Err
+}
+
+// Format parses the .er source src and returns it in canonical form. name
+// labels the diagnostics of the returned erd.ErrorList when src has errors.
+func Format(name string, src []byte) ([]byte, error) {
+	e, err := erd.ParseString(name, string(src))
+	if err != nil {
+		return nil, err
+	}
+	var buf bytes.Buffer
+	if err := Render(&buf, e); err != nil {
+		return nil, err
+	}
+	return buf.Bytes(), nil
+}
+
+// check returns an error for the first name or value of e that Render
+// could not write so that it parses back.
+func check(e *erd.Erd) error {
+	attrs := func(what string, m map[string]string) error {
+		for k, v := range m {
+			if !bareString.MatchString(k) {
+				return fmt.Errorf("attribute name %q of %s cannot be written in the erd-go format", k, what)
+			}
+			if !bareString.MatchString(v) && !quotable.MatchString(v) {
+				return fmt.Errorf("attribute %s of %s has a value that cannot be written in the erd-go format: %q", k, what, v)
+			}
+		}
+		return nil
+	}
+	if err := attrs("the title", e.Title.TitleAttributes); err != nil {
+		return err
+	}
+	for _, g := range e.Groups {
+		if !quotable.MatchString(g.Title) {
+			return fmt.Errorf("group name %q cannot be written in the erd-go format", g.Title)
+		}
+		if err := attrs(fmt.Sprintf("group %q", g.Title), g.GroupAttributes); err != nil {
+			return err
+		}
+	}
+	for _, t := range e.Tables {
+		if !bareString.MatchString(t.Title) {
+			return fmt.Errorf("table name %q cannot be written in the erd-go format", t.Title)
+		}
+		if err := attrs(fmt.Sprintf("table %q", t.Title), t.TableAttributes); err != nil {
+			return err
+		}
+		for _, c := range t.Columns {
+			// A leading '*' or '+' would be read as a key flag and a
+			// leading '#' as a comment.
+			if !bareString.MatchString(c.Title) || strings.ContainsAny(c.Title[:1], "*+#") {
+				return fmt.Errorf("column name %q of table %q cannot be written in the erd-go format", c.Title, t.Title)
+			}
+			if !quotable.MatchString(c.Type) && c.Type != "" || !quotable.MatchString(c.Default) && c.Default != "" {
+				return fmt.Errorf("column %q of table %q has a type or default that cannot be written in the erd-go format", c.Title, t.Title)
+			}
+			if err := attrs(fmt.Sprintf("column %q", c.Title), c.ColumnAttributes); err != nil {
+				return err
+			}
+		}
+		for _, idx := range t.Indexes {
+			if !indexName.MatchString(idx.Title) {
+				return fmt.Errorf("index name %q of table %q cannot be written in the erd-go format", idx.Title, t.Title)
+			}
+			for _, c := range idx.Columns {
+				if !indexName.MatchString(c) {
+					return fmt.Errorf("column name %q of index %q cannot be written in the erd-go format", c, idx.Title)
+				}
+			}
+			if err := attrs(fmt.Sprintf("index %q", idx.Title), idx.IndexAttributes); err != nil {
+				return err
+			}
+		}
+	}
+	for _, r := range e.Relations {
+		for _, name := range []string{r.LeftTableName, r.LeftColumn, r.RightTableName, r.RightColumn} {
+			if name != "" && !quotable.MatchString(name) {
+				return fmt.Errorf("relation name %q cannot be written in the erd-go format", name)
+			}
+		}
+		if err := attrs(fmt.Sprintf("relation %s--%s", r.LeftTableName, r.RightTableName), r.RelationAttributes); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+type printer struct {
+	funcs.ErrWriter
+	attached map[int][]erd.Comment
+	sections int
+}
+
+// section starts a top level element, separating it from the previous one.
+func (p *printer) section() {
+	if p.sections > 0 {
+		p.Printf("\n")
+	}
+	p.sections++
+}
+
+// comments prints the comments attached to the element at pos.
+func (p *printer) comments(pos int) {
+	for _, c := range p.attached[pos] {
+		p.Printf("%s\n", c.Text)
+		if c.BlankAfter {
+			p.Printf("\n")
+		}
+	}
+}
+
+// attachComments maps the position of each element of e to the comments
+// that precede it, that is the comments after the previous element. The
+// comments after the last element are stored under -1.
+func attachComments(e *erd.Erd) map[int][]erd.Comment {
+	attached := map[int][]erd.Comment{}
+	if len(e.Comments) == 0 {
+		return attached
+	}
+
+	var positions []int
+	if len(e.Title.TitleAttributes) > 0 {
+		positions = append(positions, e.Title.Pos)
+	}
+	for _, inc := range e.Includes {
+		positions = append(positions, inc.Pos)
+	}
+	for _, g := range declaredGroups(e) {
+		positions = append(positions, g.Pos)
+	}
+	for _, t := range e.Tables {
+		positions = append(positions, t.Pos)
+		for _, c := range t.Columns {
+			positions = append(positions, c.Pos)
+		}
+		for _, idx := range t.Indexes {
+			positions = append(positions, idx.Pos)
+		}
+	}
+	for _, r := range e.Relations {
+		positions = append(positions, r.LeftPos)
+	}
+	sort.Ints(positions)
+
+	for _, c := range e.Comments {
+		i := sort.SearchInts(positions, c.Pos+1)
+		pos := -1
+		if i < len(positions) {
+			pos = positions[i]
+		}
+		attached[pos] = append(attached[pos], c)
+	}
+	return attached
+}
+
+// declaredGroups returns the groups of e that have a group line, leaving
+// out those that tables only name.
+func declaredGroups(e *erd.Erd) []*erd.Group {
+	var groups []*erd.Group
+	for _, g := range e.Groups {
+		if g.Pos >= 0 {
+			groups = append(groups, g)
+		}
+	}
+	return groups
+}
+
+// endpoint formats a relation end, quoting the names that hold a '.' or
+// other characters the relation_name rule of the grammar excludes.
+func endpoint(table, column string) string {
+	if column == "" {
+		return relationName(table)
+	}
+	return relationName(table) + "." + relationName(column)
+}
+
+func relationName(name string) string {
+	if bareName.MatchString(name) {
+		return name
+	}
+	return fmt.Sprintf("%q", name)
+}
+
+// column formats a column line. The type, nullability and default are
+// written inline when the grammar allows it and as attributes otherwise.
+func column(c erd.Column) string {
+	var b strings.Builder
+	if c.IsPrimaryKey {
+		b.WriteString("*")
+	}
+	if c.IsForeignKey {
+		b.WriteString("+")
+	}
+	b.WriteString(c.Title)
+
+	extra := map[string]string{}
+	if c.Type != "" {
+		if bareType.MatchString(c.Type) && !keyword.MatchString(c.Type) {
+			b.WriteString(" " + c.Type)
+		} else {
+			extra["type"] = c.Type
+		}
+	}
+	if c.IsNotNull {
+		b.WriteString(" not null")
+	}
+	if c.Default != "" {
+		if bareValue.MatchString(c.Default) {
+			b.WriteString(" default " + c.Default)
+		} else {
+			extra["default"] = c.Default
+		}
+	}
+	if len(c.ColumnAttributes) > 0 || len(extra) > 0 {
+		b.WriteString(" " + attributes(c.ColumnAttributes, extra))
+	}
+	return b.String()
+}
+
+// attributes formats "{key: value, ...}" with the keys sorted, quoting only
+// the values that the string rule of the grammar does not match. Entries of extra come first, in sorted order too.
+func attributes(attrs map[string]string, extra map[string]string) string {
+	var parts []string
+	for _, m := range []map[string]string{extra, attrs} {
+		keys := make([]string, 0, len(m))
+		for k := range m {
+			keys = append(keys, k)
+		}
+		sort.Strings(keys)
+		for _, k := range keys {
+			v := m[k]
+			if !bareString.MatchString(v) {
+				v = fmt.Sprintf("%q", v)
+			}
+			parts = append(parts, k+": "+v)
+		}
+	}
+	return "{" + strings.Join(parts, ", ") + "}"
+}
@@ -0,0 +1,196 @@
+package er
+
+import (
+	"bytes"
+	"io/ioutil"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/kaishuu0123/erd-go/erd"
+)
+
+func TestRender(t *testing.T) {
+	e, err := erd.ParseString("test.er", `[Person] {bgcolor: "#ececfc"}
+*name varchar(64) not null default 'x'
++birth_location_id {type: "timestamp with time zone", label: "born"}
+created {default: "'n/a'::text"}
+index person_name (name) {unique: true}
+[Location]
+*id
+Person.birth_location_id *--1 Location {label: "born in"}
+`)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	var buf bytes.Buffer
+	if err := Render(&buf, e); err != nil {
+		t.Fatal(err)
+	}
+	want := `[Person] {bgcolor: #ececfc}
+*name varchar(64) not null default 'x'
++birth_location_id {type: "timestamp with time zone", label: born}
+created {default: "'n/a'::text"}
+index person_name (name) {unique: true}
+
+[Location]
+*id
+
+Person.birth_location_id *--1 Location {label: "born in"}
+`
+	if buf.String() != want {
+		t.Errorf("got:\n%s\nwant:\n%s", buf.String(), want)
+	}
+}
+
+func TestRender_quotedEndpoints(t *testing.T) {
+	src := `[public.users]
+*id
+
+[orders]
+*id
++user.id
+
+orders."user.id" *--1 "public.users".id
+`
+	e, err := erd.ParseString("test.er", src)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if r := e.Relations[0]; r.LeftColumn != "user.id" || r.RightTableName != "public.users" || r.RightColumn != "id" {
+		t.Fatalf("relation: %+v", r)
+	}
+	var buf bytes.Buffer
+	if err := Render(&buf, e); err != nil {
+		t.Fatal(err)
+	}
+	if buf.String() != src {
+		t.Errorf("got:\n%s\nwant:\n%s", buf.String(), src)
+	}
+}
+
+func TestRender_unwritable(t *testing.T) {
+	for _, test := range []struct {
+		change func(e *erd.Erd)
+		want   string
+	}{
+		{func(e *erd.Erd) { e.Tables[0].Title = "order items" }, `table name "order items"`},
+		{func(e *erd.Erd) { e.Tables[0].Columns[0].Title = "unit price" }, `column name "unit price" of table "orders"`},
+		{func(e *erd.Erd) { e.Tables[0].Columns[0].Title = "*id" }, `column name "*id" of table "orders"`},
+		{func(e *erd.Erd) { e.Relations[0].RightTableName = `say "hi"` }, `relation name "say \"hi\""`},
+		{func(e *erd.Erd) { e.Tables[0].TableAttributes["label"] = `a "b"` }, `attribute label of table "orders"`},
+		{func(e *erd.Erd) { e.Tables[0].Indexes[0].Title = "by id" }, `index name "by id"`},
+	} {
+		e, err := erd.ParseString("test.er", "[orders] {label: x}\n*id\nindex by_id (id)\n[items]\n*id\norders *--1 items\n")
+		if err != nil {
+			t.Fatal(err)
+		}
+		test.change(e)
+		var buf bytes.Buffer
+		err = Render(&buf, e)
+		if err == nil || !strings.Contains(err.Error(), test.want) {
+			t.Errorf("got %v, want an error about %s", err, test.want)
+		}
+		if buf.Len() > 0 {
+			t.Errorf("%s: wrote %q", test.want, buf.String())
+		}
+	}
+}
+
+// TestRender_roundTrip checks that the examples parse back into the model
+// they were written from.
+func TestRender_roundTrip(t *testing.T) {
+	for _, name := range []string{"simple", "nfldb"} {
+		src, err := ioutil.ReadFile(filepath.Join("..", "..", "examples", name+".er"))
+		if err != nil {
+			t.Fatal(err)
+		}
+		e, err := erd.ParseString(name, string(src))
+		if err != nil {
+			t.Fatal(err)
+		}
+		var first bytes.Buffer
+		if err := Render(&first, e); err != nil {
+			t.Fatal(err)
+		}
+
+		e, err = erd.ParseString(name, first.String())
+		if err != nil {
+			t.Fatalf("%s: %v\n%s", name, err, first.String())
+		}
+		var second bytes.Buffer
+		if err := Render(&second, e); err != nil {
+			t.Fatal(err)
+		}
+		if first.String() != second.String() {
+			t.Errorf("%s: output changed when parsed back:\n%s\nthen:\n%s", name, first.String(), second.String())
+		}
+	}
+}
+
+func TestFormat(t *testing.T) {
+	src := `# Schema header
+
+title {size: "20", label: "People"}
+# Shared
+include   "common/*.er"
+# Areas
+group   "Core area" {bgcolor: "#ececfc"}
+[Person]   {bgcolor:"#ececfc", group: "Core area"}
+  # Key
+  *name
+  index  idx (name)
+
+# Where they live
+[Location]
+*id
+
+# Relations
+Person *--1 Location
+# trailing
+`
+	want := `# Schema header
+
+title {label: People, size: 20}
+
+# Shared
+include "common/*.er"
+
+# Areas
+group "Core area" {bgcolor: #ececfc}
+
+[Person] {bgcolor: #ececfc, group: "Core area"}
+# Key
+*name
+index idx (name)
+
+# Where they live
+[Location]
+*id
+
+# Relations
+Person *--1 Location
+
+# trailing
+`
+	got, err := Format("test.er", []byte(src))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if string(got) != want {
+		t.Errorf("got:\n%s\nwant:\n%s", got, want)
+	}
+
+	again, err := Format("test.er", got)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if string(again) != want {
+		t.Errorf("formatting is not idempotent:\n%s", again)
+	}
+
+	if _, err := Format("test.er", []byte("[Person\n")); err == nil {
+		t.Error("Format of a broken schema succeeded, want error")
+	}
+}
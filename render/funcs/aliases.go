@@ -0,0 +1,29 @@
+package funcs
+
+import (
+	"fmt"
+
+	"github.com/kaishuu0123/erd-go/erd"
+)
+
+// Aliases maps the names of the tables of e to identifiers made by id, for
+// formats that restrict the characters of names. Since id may turn distinct
+// names into the same identifier, the later tables get "_2", "_3" and so on
+// appended to keep the identifiers unique.
+func Aliases(e *erd.Erd, id func(string) string) map[string]string {
+	aliases := map[string]string{}
+	taken := map[string]bool{}
+	for _, t := range e.Tables {
+		if _, ok := aliases[t.Title]; ok {
+			continue
+		}
+		base := id(t.Title)
+		alias := base
+		for i := 2; taken[alias]; i++ {
+			alias = fmt.Sprintf("%s_%d", base, i)
+		}
+		taken[alias] = true
+		aliases[t.Title] = alias
+	}
+	return aliases
+}
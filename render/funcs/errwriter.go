@@ -0,0 +1,21 @@
+package funcs
+
+import (
+	"fmt"
+	"io"
+)
+
+// ErrWriter remembers the first error of a series of writes, for renderers
+// that write their output piece by piece.
+type ErrWriter struct {
+	W   io.Writer
+	Err error
+}
+
+// Printf writes to W unless an earlier write failed.
+func (ew *ErrWriter) Printf(format string, args ...interface{}) {
+	if ew.Err != nil {
+		return
+	}
+	_, ew.Err = fmt.Fprintf(ew.W, format, args...)
+}
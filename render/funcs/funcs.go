@@ -0,0 +1,203 @@
+// Package funcs holds the helper functions available to every output
+// template, the embedded ones and those given with --template-dir.
+//
+// Templates are executed with the *erd.Erd of the schema as their data:
+//
+//	.Title.TitleAttributes     map of the title attributes, e.g. label
+//	.Tables                    tables in declaration order, each with
+//	  .Title                   the table name
+//	  .TableAttributes         map of the table attributes, e.g. bgcolor
+//	  .Columns                 columns in declaration order, each with
+//	    .Title .Type .Default  name, type and default, "" when not given
+//	    .IsPrimaryKey .IsForeignKey .IsNotNull
+//	    .ColumnAttributes      map of the column attributes, e.g. label
+//	  .Indexes                 indexes, each with .Title, .Columns (names),
+//	                           .IsUnique and .IndexAttributes
+//	  .HasColumnTypes          whether some column has a type
+//	  .HasColumnConstraints    whether some column is not null or has a default
+//	.Relations                 relations, each with .LeftTableName,
+//	                           .LeftColumn, .LeftCardinality, the same for
+//	                           Right, and .RelationAttributes
+//	.Groups                    groups of tables, each with .Title,
+//	                           .GroupAttributes (label, bgcolor, color) and
+//	                           .Tables
+//
+// Attribute maps may be nil; use index to read them, as in
+// {{index .ColumnAttributes "label"}}.
+package funcs
+
+import (
+	"strings"
+	"text/template"
+
+	"github.com/kaishuu0123/erd-go/erd"
+)
+
+// FuncMap returns the helper functions:
+//
+//	escapeHTML s          s escaped for HTML, XML and Graphviz HTML-like labels
+//	dotID s               s as a Graphviz identifier, quoted when needed
+//	dotQuote s            s as a quoted Graphviz string
+//	join list sep         the strings of list separated by sep
+//	columnNames columns   the names of columns
+//	isKey column          whether column is a primary or a foreign key
+//	primaryKeys table     the primary key columns of table
+//	foreignKeys table     the foreign key columns of table
+//	cardinalityName c     "zero or one", "exactly one", "zero or more" or
+//	                      "one or more" for the cardinality 0, 1, * or +
+//	cardinalityRange c    "0..1", "1", "0..N" or "1..N" for the same
+//	crowsFootLeft c       the crow's foot of Mermaid and PlantUML for the
+//	                      cardinality c on the left of "--", e.g. "}o"
+//	crowsFootRight c      the same on the right of "--", e.g. "o{"
+func FuncMap() template.FuncMap {
+	return template.FuncMap{
+		"escapeHTML":       EscapeHTML,
+		"dotID":            DotID,
+		"dotQuote":         DotQuote,
+		"join":             strings.Join,
+		"columnNames":      columnNames,
+		"isKey":            isKey,
+		"primaryKeys":      primaryKeys,
+		"foreignKeys":      foreignKeys,
+		"cardinalityName":  CardinalityName,
+		"cardinalityRange": CardinalityRange,
+		"crowsFootLeft":    CrowsFootLeft,
+		"crowsFootRight":   CrowsFootRight,
+	}
+}
+
+var htmlEscaper = strings.NewReplacer(
+	"&", "&amp;",
+	"<", "&lt;",
+	">", "&gt;",
+	`"`, "&quot;",
+	"'", "&#39;",
+)
+
+// EscapeHTML escapes the characters that are special in HTML and in the
+// HTML-like labels of Graphviz.
+func EscapeHTML(s string) string {
+	return htmlEscaper.Replace(s)
+}
+
+// DotID returns s as a Graphviz identifier. Names made of letters, digits
+// and '_' that do not start with a digit are returned as they are, other
+// names are quoted.
+func DotID(s string) string {
+	plain := s != ""
+	for i, r := range s {
+		if !(r == '_' || r >= 'a' && r <= 'z' || r >= 'A' && r <= 'Z' || i > 0 && r >= '0' && r <= '9') {
+			plain = false
+			break
+		}
+	}
+	if plain && !isDotKeyword(s) {
+		return s
+	}
+	return DotQuote(s)
+}
+
+// DotQuote returns s as a quoted Graphviz string.
+func DotQuote(s string) string {
+	s = strings.Replace(s, `\`, `\\`, -1)
+	s = strings.Replace(s, `"`, `\"`, -1)
+	s = strings.Replace(s, "\n", `\n`, -1)
+	return `"` + s + `"`
+}
+
+// isDotKeyword reports whether s is a keyword of the dot language, which
+// is case insensitive.
+func isDotKeyword(s string) bool {
+	switch strings.ToLower(s) {
+	case "node", "edge", "graph", "digraph", "subgraph", "strict":
+		return true
+	}
+	return false
+}
+
+// CardinalityName spells out a cardinality of the erd format.
+func CardinalityName(c string) string {
+	switch c {
+	case "0":
+		return "zero or one"
+	case "1":
+		return "exactly one"
+	case "*":
+		return "zero or more"
+	case "+":
+		return "one or more"
+	}
+	return c
+}
+
+// CardinalityRange writes a cardinality of the erd format as a range.
+func CardinalityRange(c string) string {
+	switch c {
+	case "0":
+		return "0..1"
+	case "*":
+		return "0..N"
+	case "+":
+		return "1..N"
+	}
+	return c
+}
+
+// CrowsFootLeft writes a cardinality in the crow's foot notation of Mermaid
+// and PlantUML, for the left end of a relation.
+func CrowsFootLeft(c string) string {
+	switch c {
+	case "0":
+		return "|o"
+	case "*":
+		return "}o"
+	case "+":
+		return "}|"
+	}
+	return "||"
+}
+
+// CrowsFootRight is CrowsFootLeft for the right end of a relation.
+func CrowsFootRight(c string) string {
+	switch c {
+	case "0":
+		return "o|"
+	case "*":
+		return "o{"
+	case "+":
+		return "|{"
+	}
+	return "||"
+}
+
+func columnNames(columns []erd.Column) []string {
+	names := make([]string, len(columns))
+	for i, c := range columns {
+		names[i] = c.Title
+	}
+	return names
+}
+
+func isKey(c erd.Column) bool {
+	return c.IsPrimaryKey || c.IsForeignKey
+}
+
+func primaryKeys(t *erd.Table) []erd.Column {
+	var keys []erd.Column
+	for _, c := range t.Columns {
+		if c.IsPrimaryKey {
+			keys = append(keys, c)
+		}
+	}
+	return keys
+}
+
+func foreignKeys(t *erd.Table) []erd.Column {
+	var keys []erd.Column
+	for _, c := range t.Columns {
+		if c.IsForeignKey {
+			keys = append(keys, c)
+		}
+	}
+	return keys
+}
@@ -0,0 +1,109 @@
+package funcs
+
+import (
+	"bytes"
+	"errors"
+	"reflect"
+	"strings"
+	"testing"
+	"text/template"
+
+	"github.com/kaishuu0123/erd-go/erd"
+)
+
+func TestEscapeHTML(t *testing.T) {
+	got := EscapeHTML(`array<int> & "more" 'x'`)
+	want := `array&lt;int&gt; &amp; &quot;more&quot; &#39;x&#39;`
+	if got != want {
+		t.Errorf("got %s, want %s", got, want)
+	}
+}
+
+func TestDotID(t *testing.T) {
+	for s, want := range map[string]string{
+		"person":      "person",
+		"_id2":        "_id2",
+		"2nd":         `"2nd"`,
+		"birth-place": `"birth-place"`,
+		"public.user": `"public.user"`,
+		"Ünïcode":     `"Ünïcode"`,
+		`say "hi"\`:   `"say \"hi\"\\"`,
+		"node":        `"node"`,
+		"Graph":       `"Graph"`,
+		"":            `""`,
+	} {
+		if got := DotID(s); got != want {
+			t.Errorf("DotID(%q) = %s, want %s", s, got, want)
+		}
+	}
+}
+
+func TestFuncMap(t *testing.T) {
+	e, err := erd.ParseString("test.er", `[Person]
+*id
++location_id
+name
+
+Person *--1 Location
+
+[Location]
+*id
+`)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	tmpl := template.Must(template.New("").Funcs(FuncMap()).Parse(`
+{{- range .Tables}}{{.Title}}: {{join (columnNames .Columns) ", "}}; pk {{join (columnNames (primaryKeys .)) ","}}; fk {{join (columnNames (foreignKeys .)) ","}};
+{{- range .Columns}}{{if isKey .}} key {{.Title}}{{end}}{{end}}
+{{end}}
+{{- range .Relations}}{{cardinalityName .LeftCardinality}} to {{cardinalityName .RightCardinality}} ({{cardinalityRange .LeftCardinality}}, {{cardinalityRange .RightCardinality}}) {{crowsFootLeft .LeftCardinality}}--{{crowsFootRight .RightCardinality}}
+{{end}}`))
+	var buf bytes.Buffer
+	if err := tmpl.Execute(&buf, e); err != nil {
+		t.Fatal(err)
+	}
+	want := `Person: id, location_id, name; pk id; fk location_id; key id key location_id
+Location: id; pk id; fk ; key id
+zero or more to exactly one (0..N, 1) }o--||
+`
+	if buf.String() != want {
+		t.Errorf("got:\n%s\nwant:\n%s", buf.String(), want)
+	}
+}
+
+func TestErrWriter(t *testing.T) {
+	var buf bytes.Buffer
+	ew := &ErrWriter{W: &buf}
+	ew.Printf("%s=%d\n", "a", 1)
+	if ew.Err != nil || buf.String() != "a=1\n" {
+		t.Errorf("got %q, %v", buf.String(), ew.Err)
+	}
+
+	ew = &ErrWriter{W: failingWriter{}}
+	ew.Printf("x")
+	first := ew.Err
+	ew.Printf("y")
+	if first == nil || ew.Err != first {
+		t.Errorf("got %v then %v, want the first error kept", first, ew.Err)
+	}
+}
+
+type failingWriter struct{}
+
+func (failingWriter) Write(p []byte) (int, error) {
+	return 0, errors.New("write failed")
+}
+
+func TestAliases(t *testing.T) {
+	e, err := erd.ParseString("test.er", "[a.b]\n[a_b]\n[a-b]\n[a_b_2]\n[c]\n")
+	if err != nil {
+		t.Fatal(err)
+	}
+	underscore := func(s string) string { return strings.NewReplacer(".", "_", "-", "_").Replace(s) }
+	got := Aliases(e, underscore)
+	want := map[string]string{"a.b": "a_b", "a_b": "a_b_2", "a-b": "a_b_3", "a_b_2": "a_b_2_2", "c": "c"}
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("got %v, want %v", got, want)
+	}
+}
@@ -0,0 +1,99 @@
+// Package mermaid renders an erd model as a Mermaid erDiagram, which GitHub
+// and GitLab display natively in markdown.
+package mermaid
+
+import (
+	"io"
+	"strings"
+	"text/template"
+
+	"github.com/kaishuu0123/erd-go/erd"
+	"github.com/kaishuu0123/erd-go/render/funcs"
+)
+
+// Root is the template that Render executes.
+const Root = "mermaid"
+
+var templates = Templates()
+
+// Templates returns a new set of the embedded templates with the helper
+// functions of package funcs and of this package. Further templates can be
+// parsed into it to override or extend them. Until Bind is called, entity
+// is the same as id.
+func Templates() *template.Template {
+	return template.Must(
+		template.New("").Funcs(funcs.FuncMap()).Funcs(template.FuncMap{
+			"id":       id,
+			"entity":   id,
+			"attrType": attrType,
+			"quote":    quote,
+		}).Parse(string(MustAsset("templates/mermaid.tmpl"))))
+}
+
+// Bind makes the entity function of t, a set returned by Templates, return
+// the unique alias of a table of e.
+func Bind(t *template.Template, e *erd.Erd) {
+	aliases := funcs.Aliases(e, id)
+	t.Funcs(template.FuncMap{"entity": func(name string) string {
+		if alias, ok := aliases[name]; ok {
+			return alias
+		}
+		return id(name)
+	}})
+}
+
+// Render writes the diagram of e to w.
+func Render(w io.Writer, e *erd.Erd) error {
+	t := template.Must(templates.Clone())
+	Bind(t, e)
+	return t.ExecuteTemplate(w, Root, e)
+}
+
+// id turns s into a Mermaid identifier by replacing the characters Mermaid
+// does not accept in entity and attribute names with '_'. Tables whose
+// identifiers differ from their names are declared as alias["name"].
+func id(s string) string {
+	var b strings.Builder
+	for i, r := range s {
+		switch {
+		case r == '_' || r >= 'a' && r <= 'z' || r >= 'A' && r <= 'Z':
+		case i > 0 && (r == '-' || r >= '0' && r <= '9'):
+		default:
+			r = '_'
+		}
+		b.WriteRune(r)
+	}
+	if b.Len() == 0 {
+		return "_"
+	}
+	return b.String()
+}
+
+// attrType is like id but keeps the brackets of types such as varchar(64)
+// or int[]. Mermaid requires a type, so untyped columns get "unknown".
+func attrType(s string) string {
+	if s == "" {
+		return "unknown"
+	}
+	var b strings.Builder
+	for _, r := range s {
+		switch {
+		case strings.ContainsRune("()[]-_", r) || r >= '0' && r <= '9':
+		case r >= 'a' && r <= 'z' || r >= 'A' && r <= 'Z':
+		default:
+			r = '_'
+		}
+		b.WriteRune(r)
+	}
+	t := b.String()
+	if t[0] >= '0' && t[0] <= '9' || strings.ContainsRune("()[]-", rune(t[0])) {
+		t = "_" + t
+	}
+	return t
+}
+
+// quote returns s as a Mermaid string. Mermaid strings cannot contain
+// double quotes, so they are replaced with single ones.
+func quote(s string) string {
+	return `"` + strings.Replace(s, `"`, `'`, -1) + `"`
+}
@@ -0,0 +1,86 @@
+package mermaid
+
+import (
+	"bytes"
+	"testing"
+
+	"github.com/kaishuu0123/erd-go/erd"
+)
+
+func TestRender(t *testing.T) {
+	e, err := erd.ParseString("test.er", `[Person]
+*name varchar(64) {label: "full name"}
++birth_location_id
+*+id int
+
+[Birth$Place]
+*id
+city
+
+Person 0--1 Person
+Person *--+ Birth$Place {label: "born in"}
+`)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	var buf bytes.Buffer
+	if err := Render(&buf, e); err != nil {
+		t.Fatal(err)
+	}
+	want := `erDiagram
+    Person {
+        varchar(64) name PK "full name"
+        unknown birth_location_id FK
+        int id PK, FK
+    }
+    Birth_Place["Birth$Place"] {
+        unknown id PK
+        unknown city
+    }
+    Person |o--|| Person : ""
+    Person }o--|{ Birth_Place : "born in"
+`
+	if buf.String() != want {
+		t.Errorf("got:\n%s\nwant:\n%s", buf.String(), want)
+	}
+}
+
+func TestRender_aliases(t *testing.T) {
+	e, err := erd.ParseString("test.er", `[a.b]
+*id
+
+[a_b]
+*id
+
+[ünïcode]
+*id
+
+"a.b" *--1 a_b
+ünïcode *--1 "a.b"
+`)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	var buf bytes.Buffer
+	if err := Render(&buf, e); err != nil {
+		t.Fatal(err)
+	}
+	want := `erDiagram
+    a_b["a.b"] {
+        unknown id PK
+    }
+    a_b_2["a_b"] {
+        unknown id PK
+    }
+    _n_code["ünïcode"] {
+        unknown id PK
+    }
+    a_b }o--|| a_b_2 : ""
+    _n_code }o--|| a_b : ""
+`
+	if buf.String() != want {
+		t.Errorf("got:\n%s\nwant:\n%s", buf.String(), want)
+	}
+}
@@ -0,0 +1,237 @@
+// Code generated by go-bindata.
+// sources:
+// templates/mermaid.tmpl
+// DO NOT EDIT!
+
+package mermaid
+
+import (
+	"bytes"
+	"compress/gzip"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"strings"
+	"time"
+)
+
+func bindataRead(data []byte, name string) ([]byte, error) {
+	gz, err := gzip.NewReader(bytes.NewBuffer(data))
+	if err != nil {
+		return nil, fmt.Errorf("Read %q: %v", name, err)
+	}
+
+	var buf bytes.Buffer
+	_, err = io.Copy(&buf, gz)
+	clErr := gz.Close()
+
+	if err != nil {
+		return nil, fmt.Errorf("Read %q: %v", name, err)
+	}
+	if clErr != nil {
+		return nil, err
+	}
+
+	return buf.Bytes(), nil
+}
+
+type asset struct {
+	bytes []byte
+	info  os.FileInfo
+}
+
+type bindataFileInfo struct {
+	name    string
+	size    int64
+	mode    os.FileMode
+	modTime time.Time
+}
+
+func (fi bindataFileInfo) Name() string {
+	return fi.name
+}
+func (fi bindataFileInfo) Size() int64 {
+	return fi.size
+}
+func (fi bindataFileInfo) Mode() os.FileMode {
+	return fi.mode
+}
+func (fi bindataFileInfo) ModTime() time.Time {
+	return fi.modTime
+}
+func (fi bindataFileInfo) IsDir() bool {
+	return false
+}
+func (fi bindataFileInfo) Sys() interface{} {
+	return nil
+}
+
+var _templatesMermaidTmpl = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\x74\x51\xcd\x6a\xc3\x30\x0c\xbe\xe7\x29\x44\x4e\x2d\x2c\x79\x80\xdd\x46\x47\x61\x64\x8c\x52\x7a\x1b\x3b\xb8\xb3\x92\x09\x1c\xa7\x73\x54\x36\x23\xf4\xee\xc3\x59\x96\x26\x2b\x25\x10\x63\xeb\xfb\xf1\xf7\x59\xc4\x62\x4d\x1e\x21\x6f\x31\xb4\x86\x6c\xae\x8a\xe1\x91\x4c\x13\x4c\x9b\x89\x14\x10\x8c\x6f\x10\xca\x83\x39\x3a\xec\x55\x33\x00\x00\x11\xf4\x4c\x1c\xa1\x3c\x10\x3b\x54\x15\xa1\x1a\x3c\xc2\x6a\x71\xbe\x9e\xe6\xaf\x22\x9f\xe7\x8e\x71\x3a\x78\x4b\x12\x56\x15\x64\x14\x9c\x8c\x36\x9d\x3b\xb7\xfe\xcf\x29\x7d\x22\x86\x39\x1c\xe2\x29\xd1\xe3\x09\x13\x4b\xc8\x4e\x5a\x33\x60\x01\x54\x83\xf1\x16\xca\xa7\x7e\x17\xa8\x35\x21\x56\x18\xd3\x6e\xdb\x05\xa4\xc6\x57\x18\x55\x61\x57\xdd\xc1\xb6\x5a\xf0\xd0\xf5\x98\xc8\x0b\xe2\x00\xbd\x05\x5b\x28\xfe\x57\xf3\xf6\xfa\x5e\x63\xb4\x07\xe6\x40\xc7\x33\x63\x5f\x3a\x73\x44\x37\xc4\x19\xdb\xb9\x85\x18\xdb\xca\xae\xf5\x35\xbb\xec\x67\x2d\xee\xd1\x19\xa6\xce\x5f\xbf\xd8\x33\xd6\x3c\x3c\xe6\x8b\x69\x7f\xab\x7c\x0f\xdd\x57\xbf\xed\x3a\x4e\x23\x28\xd3\x7f\x63\x82\x25\x6f\x1c\x71\x54\x2d\x8a\x19\x66\x4f\xcd\x07\x43\x39\x2c\x0b\xd4\xcc\x62\x18\xce\x3d\xee\xa7\x84\x2b\xf2\x16\xbf\x2f\xf7\xbb\x44\x85\x7c\x68\x23\x5f\xeb\x32\x12\x7a\xab\x9a\xfd\x0c\x00\x81\xf5\x69\xf1\xa8\x02\x00\x00")
+
+func templatesMermaidTmplBytes() ([]byte, error) {
+	return bindataRead(
+		_templatesMermaidTmpl,
+		"templates/mermaid.tmpl",
+	)
+}
+
+func templatesMermaidTmpl() (*asset, error) {
+	bytes, err := templatesMermaidTmplBytes()
+	if err != nil {
+		return nil, err
+	}
+
+	info := bindataFileInfo{name: "templates/mermaid.tmpl", size: 680, mode: os.FileMode(420), modTime: time.Unix(1792140455, 0)}
+	a := &asset{bytes: bytes, info: info}
+	return a, nil
+}
+
+// Asset loads and returns the asset for the given name.
+// It returns an error if the asset could not be found or
+// could not be loaded.
+func Asset(name string) ([]byte, error) {
+	cannonicalName := strings.Replace(name, "\\", "/", -1)
+	if f, ok := _bindata[cannonicalName]; ok {
+		a, err := f()
+		if err != nil {
+			return nil, fmt.Errorf("Asset %s can't read by error: %v", name, err)
+		}
+		return a.bytes, nil
+	}
+	return nil, fmt.Errorf("Asset %s not found", name)
+}
+
+// MustAsset is like Asset but panics when Asset would return an error.
+// It simplifies safe initialization of global variables.
+func MustAsset(name string) []byte {
+	a, err := Asset(name)
+	if err != nil {
+		panic("asset: Asset(" + name + "): " + err.Error())
+	}
+
+	return a
+}
+
+// AssetInfo loads and returns the asset info for the given name.
+// It returns an error if the asset could not be found or
+// could not be loaded.
+func AssetInfo(name string) (os.FileInfo, error) {
+	cannonicalName := strings.Replace(name, "\\", "/", -1)
+	if f, ok := _bindata[cannonicalName]; ok {
+		a, err := f()
+		if err != nil {
+			return nil, fmt.Errorf("AssetInfo %s can't read by error: %v", name, err)
+		}
+		return a.info, nil
+	}
+	return nil, fmt.Errorf("AssetInfo %s not found", name)
+}
+
+// AssetNames returns the names of the assets.
+func AssetNames() []string {
+	names := make([]string, 0, len(_bindata))
+	for name := range _bindata {
+		names = append(names, name)
+	}
+	return names
+}
+
+// _bindata is a table, holding each asset generator, mapped to its name.
+var _bindata = map[string]func() (*asset, error){
+	"templates/mermaid.tmpl": templatesMermaidTmpl,
+}
+
+// AssetDir returns the file names below a certain
+// directory embedded in the file by go-bindata.
+// For example if you run go-bindata on data/... and data contains the
+// following hierarchy:
+//     data/
+//       foo.txt
+//       img/
+//         a.png
+//         b.png
+// then AssetDir("data") would return []string{"foo.txt", "img"}
+// AssetDir("data/img") would return []string{"a.png", "b.png"}
+// AssetDir("foo.txt") and AssetDir("notexist") would return an error
+// AssetDir("") will return []string{"data"}.
+func AssetDir(name string) ([]string, error) {
+	node := _bintree
+	if len(name) != 0 {
+		cannonicalName := strings.Replace(name, "\\", "/", -1)
+		pathList := strings.Split(cannonicalName, "/")
+		for _, p := range pathList {
+			node = node.Children[p]
+			if node == nil {
+				return nil, fmt.Errorf("Asset %s not found", name)
+			}
+		}
+	}
+	if node.Func != nil {
+		return nil, fmt.Errorf("Asset %s not found", name)
+	}
+	rv := make([]string, 0, len(node.Children))
+	for childName := range node.Children {
+		rv = append(rv, childName)
+	}
+	return rv, nil
+}
+
+type bintree struct {
+	Func     func() (*asset, error)
+	Children map[string]*bintree
+}
+var _bintree = &bintree{nil, map[string]*bintree{
+	"templates": &bintree{nil, map[string]*bintree{
+		"mermaid.tmpl": &bintree{templatesMermaidTmpl, map[string]*bintree{}},
+	}},
+}}
+
+// RestoreAsset restores an asset under the given directory
+func RestoreAsset(dir, name string) error {
+	data, err := Asset(name)
+	if err != nil {
+		return err
+	}
+	info, err := AssetInfo(name)
+	if err != nil {
+		return err
+	}
+	err = os.MkdirAll(_filePath(dir, filepath.Dir(name)), os.FileMode(0755))
+	if err != nil {
+		return err
+	}
+	err = ioutil.WriteFile(_filePath(dir, name), data, info.Mode())
+	if err != nil {
+		return err
+	}
+	err = os.Chtimes(_filePath(dir, name), info.ModTime(), info.ModTime())
+	if err != nil {
+		return err
+	}
+	return nil
+}
+
+// RestoreAssets restores an asset under the given directory recursively
+func RestoreAssets(dir, name string) error {
+	children, err := AssetDir(name)
+	// File
+	if err != nil {
+		return RestoreAsset(dir, name)
+	}
+	// Dir
+	for _, child := range children {
+		err = RestoreAssets(dir, filepath.Join(name, child))
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func _filePath(dir, name string) string {
+	cannonicalName := strings.Replace(name, "\\", "/", -1)
+	return filepath.Join(append([]string{dir}, strings.Split(cannonicalName, "/")...)...)
+}
+
@@ -0,0 +1,90 @@
+// Package plantuml renders an erd model as a PlantUML entity diagram.
+package plantuml
+
+import (
+	"io"
+	"strings"
+	"text/template"
+
+	"github.com/kaishuu0123/erd-go/erd"
+	"github.com/kaishuu0123/erd-go/render/funcs"
+)
+
+// Root is the template that Render executes.
+const Root = "plantuml"
+
+var templates = Templates()
+
+// Templates returns a new set of the embedded templates with the helper
+// functions of package funcs and of this package. Further templates can be
+// parsed into it to override or extend them. Until Bind is called, entity
+// is the same as id.
+func Templates() *template.Template {
+	return template.Must(
+		template.New("").Funcs(funcs.FuncMap()).Funcs(template.FuncMap{
+			"id":     id,
+			"entity": id,
+			"text":   text,
+			"quote":  quote,
+			"color":  color,
+		}).Parse(string(MustAsset("templates/plantuml.tmpl"))))
+}
+
+// Bind makes the entity function of t, a set returned by Templates, return
+// the unique alias of a table of e.
+func Bind(t *template.Template, e *erd.Erd) {
+	aliases := funcs.Aliases(e, id)
+	t.Funcs(template.FuncMap{"entity": func(name string) string {
+		if alias, ok := aliases[name]; ok {
+			return alias
+		}
+		return id(name)
+	}})
+}
+
+// Render writes the diagram of e to w.
+func Render(w io.Writer, e *erd.Erd) error {
+	t := template.Must(templates.Clone())
+	Bind(t, e)
+	return t.ExecuteTemplate(w, Root, e)
+}
+
+// id turns a table name into a PlantUML alias by replacing everything but
+// letters, digits and '_' with '_'.
+func id(s string) string {
+	return strings.Map(func(r rune) rune {
+		if r == '_' || r >= 'a' && r <= 'z' || r >= 'A' && r <= 'Z' || r >= '0' && r <= '9' {
+			return r
+		}
+		return '_'
+	}, s)
+}
+
+var textEscaper = strings.NewReplacer(
+	`"`, "<U+0022>",
+	`\`, "<U+005C>",
+	"<", "<U+003C>",
+	"\r", "",
+	"\n", `\n`,
+)
+
+// text escapes s for PlantUML: the characters that would end a quoted
+// string or start markup are written as <U+XXXX> and line breaks as \n, so
+// that s is shown as it is.
+func text(s string) string {
+	return textEscaper.Replace(s)
+}
+
+// quote returns s escaped by text between double quotes.
+func quote(s string) string {
+	return `"` + text(s) + `"`
+}
+
+// color returns a bgcolor attribute, which may be a name or a hex value
+// with or without its '#', in the form PlantUML expects.
+func color(s string) string {
+	if strings.HasPrefix(s, "#") {
+		return s
+	}
+	return "#" + s
+}
@@ -0,0 +1,161 @@
+package plantuml
+
+import (
+	"bytes"
+	"strings"
+	"testing"
+
+	"github.com/kaishuu0123/erd-go/erd"
+)
+
+func TestRender(t *testing.T) {
+	e, err := erd.ParseString("test.er", `title {label: "People"}
+
+[Person] {bgcolor: "#d0e0d0"}
+name varchar(64) not null
+*id int
++birth_location_id
+
+[Location] {bgcolor: "lightblue"}
+*id
+
+Person *--1 Location {label: "born in"}
+Person 0--+ Person
+`)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	var buf bytes.Buffer
+	if err := Render(&buf, e); err != nil {
+		t.Fatal(err)
+	}
+	want := `@startuml
+title People
+hide circle
+
+entity "Person" as Person #d0e0d0 {
+  * id : int
+  --
+  * name : varchar(64)
+  birth_location_id <<FK>>
+}
+
+entity "Location" as Location #lightblue {
+  * id
+  --
+}
+
+Person }o--|| Location : born in
+Person |o--|{ Person
+@enduml
+`
+	if buf.String() != want {
+		t.Errorf("got:\n%s\nwant:\n%s", buf.String(), want)
+	}
+}
+
+func TestRender_groups(t *testing.T) {
+	e, err := erd.ParseString("test.er", `group "Billing" {bgcolor: "#ececfc"}
+
+[invoice] {group: Billing}
+*id
+
+[meta]
+x
+`)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	var buf bytes.Buffer
+	if err := Render(&buf, e); err != nil {
+		t.Fatal(err)
+	}
+	want := `@startuml
+hide circle
+
+package "Billing" #ececfc {
+entity "invoice" as invoice {
+  * id
+  --
+}
+}
+
+entity "meta" as meta {
+  --
+  x
+}
+
+@enduml
+`
+	if buf.String() != want {
+		t.Errorf("got:\n%s\nwant:\n%s", buf.String(), want)
+	}
+}
+
+func TestRender_escaping(t *testing.T) {
+	// Such names come from the SQL and JSON importers.
+	e := &erd.Erd{Tables: []*erd.Table{
+		{Title: `we"ird`, Columns: []erd.Column{{Title: `"id"`, Type: "array<int>", IsPrimaryKey: true}}},
+		{Title: `back\slash <b>`},
+	}}
+	e.Title.TitleAttributes = map[string]string{"label": "two\nlines"}
+	e.Relations = []erd.Relation{{
+		LeftTableName: `we"ird`, LeftCardinality: "*",
+		RightTableName: `back\slash <b>`, RightCardinality: "1",
+		RelationAttributes: map[string]string{"label": `say "hi"`},
+	}}
+
+	var buf bytes.Buffer
+	if err := Render(&buf, e); err != nil {
+		t.Fatal(err)
+	}
+	want := `@startuml
+title two\nlines
+hide circle
+
+entity "we<U+0022>ird" as we_ird {
+  * <U+0022>id<U+0022> : array<U+003C>int>
+  --
+}
+
+entity "back<U+005C>slash <U+003C>b>" as back_slash__b_ {
+  --
+}
+
+we_ird }o--|| back_slash__b_ : say <U+0022>hi<U+0022>
+@enduml
+`
+	if buf.String() != want {
+		t.Errorf("got:\n%s\nwant:\n%s", buf.String(), want)
+	}
+}
+
+func TestRender_aliases(t *testing.T) {
+	e, err := erd.ParseString("test.er", `[a.b]
+*id
+
+[a_b]
+*id
+
+"a.b" *--1 a_b
+`)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	var buf bytes.Buffer
+	if err := Render(&buf, e); err != nil {
+		t.Fatal(err)
+	}
+	for _, want := range []string{
+		`entity "a.b" as a_b {`,
+		`entity "a_b" as a_b_2 {`,
+		`a_b }o--|| a_b_2`,
+	} {
+		if !strings.Contains(buf.String(), want) {
+			t.Errorf("output lacks %s:\n%s", want, buf.String())
+		}
+	}
+}
@@ -0,0 +1,237 @@
+// Code generated by go-bindata.
+// sources:
+// templates/plantuml.tmpl
+// DO NOT EDIT!
+
+package plantuml
+
+import (
+	"bytes"
+	"compress/gzip"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"strings"
+	"time"
+)
+
+func bindataRead(data []byte, name string) ([]byte, error) {
+	gz, err := gzip.NewReader(bytes.NewBuffer(data))
+	if err != nil {
+		return nil, fmt.Errorf("Read %q: %v", name, err)
+	}
+
+	var buf bytes.Buffer
+	_, err = io.Copy(&buf, gz)
+	clErr := gz.Close()
+
+	if err != nil {
+		return nil, fmt.Errorf("Read %q: %v", name, err)
+	}
+	if clErr != nil {
+		return nil, err
+	}
+
+	return buf.Bytes(), nil
+}
+
+type asset struct {
+	bytes []byte
+	info  os.FileInfo
+}
+
+type bindataFileInfo struct {
+	name    string
+	size    int64
+	mode    os.FileMode
+	modTime time.Time
+}
+
+func (fi bindataFileInfo) Name() string {
+	return fi.name
+}
+func (fi bindataFileInfo) Size() int64 {
+	return fi.size
+}
+func (fi bindataFileInfo) Mode() os.FileMode {
+	return fi.mode
+}
+func (fi bindataFileInfo) ModTime() time.Time {
+	return fi.modTime
+}
+func (fi bindataFileInfo) IsDir() bool {
+	return false
+}
+func (fi bindataFileInfo) Sys() interface{} {
+	return nil
+}
+
+var _templatesPlantumlTmpl = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\xac\x53\x4d\x6b\xdc\x30\x10\xbd\xeb\x57\x0c\x3e\xb5\x05\xfb\x07\x94\x10\x52\x02\x29\x65\xcb\x52\x42\xee\x41\x6b\xcf\x7a\x45\x65\xc9\x95\xc7\x24\x66\x98\xff\x5e\x24\x6b\xb3\xda\x6d\x9a\xe6\xd0\xcb\x5a\x9a\xaf\xf7\xe6\xe9\x2d\x73\x87\x7b\xe3\x10\xaa\xd1\x6a\x47\xf3\x60\x2b\x91\x9b\x89\x74\x88\x67\xc5\x5c\xc3\x93\xa1\x03\x34\x0f\x86\x2c\xae\xbf\x5f\x88\x82\xd9\xcd\x84\x53\x63\xf5\x0e\xad\x88\xa2\x18\x07\x66\xc2\x67\x82\x46\x24\x35\xa2\xeb\x44\xd4\xc1\x74\x08\xad\x09\xad\xc5\x14\x0d\xda\xf5\x08\xcd\xd7\xe0\xe7\x71\x12\x51\x6a\xd4\xed\x4f\xdd\x23\x30\x27\x24\xe3\x3a\x7c\xce\xf9\x13\x12\x54\x09\xaa\x12\x61\xfe\x35\x7b\xc2\x88\xc2\x8c\x76\xc2\x22\x94\xe8\xc5\x7b\x82\xfe\xc7\xc0\x5d\xdf\x7a\xeb\x43\x25\x02\xcc\xe9\x98\x67\xc6\x5e\xe0\x92\xec\x83\xde\x59\x9c\x62\x92\x70\x18\xad\xa6\x42\xaf\x47\x74\x64\x68\xa9\x5e\x9a\xa1\x16\x51\xa5\x02\xcc\x7f\x8c\x31\x7b\x70\x9e\xe0\x43\xe6\x96\xe6\x97\xdc\xfa\xa8\x4e\xf5\xf1\x9d\x88\x2f\x9f\x92\xf3\x3d\x5a\x4d\xc6\xbb\xa8\x71\x4c\x47\x92\xd0\x7c\xc7\x3d\x25\xb4\xad\x1e\x30\xae\xc9\x6d\xf0\x4f\xd3\x9d\xf7\x14\x53\x6b\xc1\xad\x0e\x9d\x71\xda\x1a\x5a\x44\xea\xba\xa8\xb9\x37\xfd\x81\xa0\x49\x9f\xb3\x2a\x38\x41\xa4\x64\x81\x71\x32\x51\xde\xf6\xc8\xec\xb5\xd7\x85\xcf\x85\x8b\xca\xad\xd6\xd3\x0d\xba\x6e\xf5\xe5\x7a\x4f\xb3\x2f\x1d\xfc\xd8\x7a\x3b\x0f\xae\xca\x9d\x66\x0f\x3e\x40\xf3\x6d\xfa\x11\xcc\xa0\xc3\xb2\xc1\x25\xde\xb6\x9e\xb6\xb3\xb5\x22\x9f\x20\x4f\x3b\x02\x67\x17\x95\xe6\x5f\x46\x7c\x83\x9b\xd9\xc7\x81\x77\x3e\xa0\xe9\xdd\x06\xa3\x1c\x57\x57\x77\x9b\xeb\xeb\xb2\xe8\x2d\xc2\xf9\x41\x45\x54\x16\xf1\xd2\xd1\xa0\xa7\x42\xe1\x1c\xcc\xfe\xbe\x74\x4f\x93\x8d\xfd\x0e\x5f\xdf\x26\xa1\x8e\x8e\x3c\x93\x48\x44\x01\xbc\xea\xbe\xac\xee\xf9\xdf\xfc\xb8\x29\x40\x5d\xff\x1d\x21\x7a\xfe\x7f\xa0\x88\x62\x46\xd7\x89\xa8\xdf\x03\x00\x19\x0e\xed\xd3\xc0\x04\x00\x00")
+
+func templatesPlantumlTmplBytes() ([]byte, error) {
+	return bindataRead(
+		_templatesPlantumlTmpl,
+		"templates/plantuml.tmpl",
+	)
+}
+
+func templatesPlantumlTmpl() (*asset, error) {
+	bytes, err := templatesPlantumlTmplBytes()
+	if err != nil {
+		return nil, err
+	}
+
+	info := bindataFileInfo{name: "templates/plantuml.tmpl", size: 1216, mode: os.FileMode(420), modTime: time.Unix(1792140508, 0)}
+	a := &asset{bytes: bytes, info: info}
+	return a, nil
+}
+
+// Asset loads and returns the asset for the given name.
+// It returns an error if the asset could not be found or
+// could not be loaded.
+func Asset(name string) ([]byte, error) {
+	cannonicalName := strings.Replace(name, "\\", "/", -1)
+	if f, ok := _bindata[cannonicalName]; ok {
+		a, err := f()
+		if err != nil {
+			return nil, fmt.Errorf("Asset %s can't read by error: %v", name, err)
+		}
+		return a.bytes, nil
+	}
+	return nil, fmt.Errorf("Asset %s not found", name)
+}
+
+// MustAsset is like Asset but panics when Asset would return an error.
+// It simplifies safe initialization of global variables.
+func MustAsset(name string) []byte {
+	a, err := Asset(name)
+	if err != nil {
+		panic("asset: Asset(" + name + "): " + err.Error())
+	}
+
+	return a
+}
+
+// AssetInfo loads and returns the asset info for the given name.
+// It returns an error if the asset could not be found or
+// could not be loaded.
+func AssetInfo(name string) (os.FileInfo, error) {
+	cannonicalName := strings.Replace(name, "\\", "/", -1)
+	if f, ok := _bindata[cannonicalName]; ok {
+		a, err := f()
+		if err != nil {
+			return nil, fmt.Errorf("AssetInfo %s can't read by error: %v", name, err)
+		}
+		return a.info, nil
+	}
+	return nil, fmt.Errorf("AssetInfo %s not found", name)
+}
+
+// AssetNames returns the names of the assets.
+func AssetNames() []string {
+	names := make([]string, 0, len(_bindata))
+	for name := range _bindata {
+		names = append(names, name)
+	}
+	return names
+}
+
+// _bindata is a table, holding each asset generator, mapped to its name.
+var _bindata = map[string]func() (*asset, error){
+	"templates/plantuml.tmpl": templatesPlantumlTmpl,
+}
+
+// AssetDir returns the file names below a certain
+// directory embedded in the file by go-bindata.
+// For example if you run go-bindata on data/... and data contains the
+// following hierarchy:
+//     data/
+//       foo.txt
+//       img/
+//         a.png
+//         b.png
+// then AssetDir("data") would return []string{"foo.txt", "img"}
+// AssetDir("data/img") would return []string{"a.png", "b.png"}
+// AssetDir("foo.txt") and AssetDir("notexist") would return an error
+// AssetDir("") will return []string{"data"}.
+func AssetDir(name string) ([]string, error) {
+	node := _bintree
+	if len(name) != 0 {
+		cannonicalName := strings.Replace(name, "\\", "/", -1)
+		pathList := strings.Split(cannonicalName, "/")
+		for _, p := range pathList {
+			node = node.Children[p]
+			if node == nil {
+				return nil, fmt.Errorf("Asset %s not found", name)
+			}
+		}
+	}
+	if node.Func != nil {
+		return nil, fmt.Errorf("Asset %s not found", name)
+	}
+	rv := make([]string, 0, len(node.Children))
+	for childName := range node.Children {
+		rv = append(rv, childName)
+	}
+	return rv, nil
+}
+
+type bintree struct {
+	Func     func() (*asset, error)
+	Children map[string]*bintree
+}
+var _bintree = &bintree{nil, map[string]*bintree{
+	"templates": &bintree{nil, map[string]*bintree{
+		"plantuml.tmpl": &bintree{templatesPlantumlTmpl, map[string]*bintree{}},
+	}},
+}}
+
+// RestoreAsset restores an asset under the given directory
+func RestoreAsset(dir, name string) error {
+	data, err := Asset(name)
+	if err != nil {
+		return err
+	}
+	info, err := AssetInfo(name)
+	if err != nil {
+		return err
+	}
+	err = os.MkdirAll(_filePath(dir, filepath.Dir(name)), os.FileMode(0755))
+	if err != nil {
+		return err
+	}
+	err = ioutil.WriteFile(_filePath(dir, name), data, info.Mode())
+	if err != nil {
+		return err
+	}
+	err = os.Chtimes(_filePath(dir, name), info.ModTime(), info.ModTime())
+	if err != nil {
+		return err
+	}
+	return nil
+}
+
+// RestoreAssets restores an asset under the given directory recursively
+func RestoreAssets(dir, name string) error {
+	children, err := AssetDir(name)
+	// File
+	if err != nil {
+		return RestoreAsset(dir, name)
+	}
+	// Dir
+	for _, child := range children {
+		err = RestoreAssets(dir, filepath.Join(name, child))
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func _filePath(dir, name string) string {
+	cannonicalName := strings.Replace(name, "\\", "/", -1)
+	return filepath.Join(append([]string{dir}, strings.Split(cannonicalName, "/")...)...)
+}
+
@@ -0,0 +1,268 @@
+// Package png draws an erd model as a PNG image with the Go fonts, for when
+// Graphviz is not available.
+package png
+
+import (
+	"fmt"
+	"image"
+	"image/color"
+	"image/png"
+	"io"
+	"math"
+	"strconv"
+	"strings"
+
+	"github.com/kaishuu0123/erd-go/erd"
+	"github.com/kaishuu0123/erd-go/render/diagram"
+	"golang.org/x/image/colornames"
+	"golang.org/x/image/font"
+	"golang.org/x/image/font/gofont/gobold"
+	"golang.org/x/image/font/gofont/gobolditalic"
+	"golang.org/x/image/font/gofont/goitalic"
+	"golang.org/x/image/font/gofont/goregular"
+	"golang.org/x/image/font/opentype"
+	"golang.org/x/image/math/fixed"
+	"golang.org/x/image/vector"
+)
+
+// DefaultDPI draws one pixel per unit of the layout, the size of the svg
+// format.
+const DefaultDPI = 96
+
+// Render writes the diagram of e to w as a PNG image at the resolution dpi.
+func Render(w io.Writer, e *erd.Erd, dpi float64) error {
+	if dpi <= 0 || dpi > 2400 {
+		return fmt.Errorf("png: invalid resolution %v dpi", dpi)
+	}
+	fonts, err := loadFonts()
+	if err != nil {
+		return err
+	}
+	c := &canvas{scale: dpi / DefaultDPI, fonts: fonts, faces: map[faceKey]font.Face{}}
+	l := diagram.New(e, c.measure)
+	width := int(math.Ceil(l.Width * c.scale))
+	height := int(math.Ceil(l.Height * c.scale))
+	c.img = image.NewRGBA(image.Rect(0, 0, width, height))
+	l.Draw(c)
+	return png.Encode(w, c.img)
+}
+
+// fontSet holds the regular, bold, italic and bold italic faces.
+type fontSet [4]*opentype.Font
+
+func loadFonts() (*fontSet, error) {
+	var fonts fontSet
+	for i, ttf := range [][]byte{goregular.TTF, gobold.TTF, goitalic.TTF, gobolditalic.TTF} {
+		f, err := opentype.Parse(ttf)
+		if err != nil {
+			return nil, err
+		}
+		fonts[i] = f
+	}
+	return &fonts, nil
+}
+
+type faceKey struct {
+	style int
+	size  float64
+}
+
+// canvas draws on an image, scaling the coordinates of the layout.
+type canvas struct {
+	img   *image.RGBA
+	scale float64
+	fonts *fontSet
+	faces map[faceKey]font.Face
+}
+
+func (c *canvas) face(style diagram.TextStyle) font.Face {
+	key := faceKey{size: style.Size * c.scale}
+	if style.Bold {
+		key.style |= 1
+	}
+	if style.Italic {
+		key.style |= 2
+	}
+	if f, ok := c.faces[key]; ok {
+		return f
+	}
+	// The parsed Go fonts are valid, so NewFace cannot fail.
+	f, _ := opentype.NewFace(c.fonts[key.style], &opentype.FaceOptions{Size: key.size, DPI: 72, Hinting: font.HintingNone})
+	c.faces[key] = f
+	return f
+}
+
+// measure returns the width of s in units of the layout.
+func (c *canvas) measure(s string, style diagram.TextStyle) float64 {
+	return fixedToFloat(font.MeasureString(c.face(style), s)) / c.scale
+}
+
+func (c *canvas) Begin(class, id string) {}
+
+func (c *canvas) End() {}
+
+func (c *canvas) Rect(x, y, w, h, radius float64, fill, stroke string) {
+	if stroke != "" {
+		c.fill(roundedRect(x-0.5, y-0.5, w+1, h+1, radius+0.5), parseColor(stroke, color.Black))
+		x, y, w, h, radius = x+0.5, y+0.5, w-1, h-1, radius-0.5
+	}
+	c.fill(roundedRect(x, y, w, h, radius), parseColor(fill, color.White))
+}
+
+func (c *canvas) Line(x1, y1, x2, y2 float64, stroke string) {
+	c.fill(segment(x1, y1, x2, y2), parseColor(stroke, color.Black))
+}
+
+func (c *canvas) Circle(cx, cy, r float64, fill, stroke string) {
+	if stroke != "" {
+		c.fill(circle(cx, cy, r+0.5), parseColor(stroke, color.Black))
+		r -= 0.5
+	}
+	c.fill(circle(cx, cy, r), parseColor(fill, color.White))
+}
+
+func (c *canvas) Curve(x1, y1, c1x, c1y, c2x, c2y, x2, y2 float64, stroke string) {
+	const steps = 32
+	var polygons [][]point
+	px, py := x1, y1
+	for i := 1; i <= steps; i++ {
+		t := float64(i) / steps
+		u := 1 - t
+		x := u*u*u*x1 + 3*u*u*t*c1x + 3*u*t*t*c2x + t*t*t*x2
+		y := u*u*u*y1 + 3*u*u*t*c1y + 3*u*t*t*c2y + t*t*t*y2
+		polygons = append(polygons, segment(px, py, x, y)...)
+		px, py = x, y
+	}
+	c.fill(polygons, parseColor(stroke, color.Black))
+}
+
+func (c *canvas) Text(x, y float64, s string, style diagram.TextStyle) {
+	width := c.measure(s, style)
+	if style.Anchor == diagram.AnchorMiddle {
+		x -= width / 2
+	}
+	d := &font.Drawer{
+		Dst:  c.img,
+		Src:  image.NewUniform(parseColor(style.Color, color.Black)),
+		Face: c.face(style),
+		Dot:  fixed.Point26_6{X: floatToFixed(x * c.scale), Y: floatToFixed(y * c.scale)},
+	}
+	d.DrawString(s)
+	if style.Underline {
+		c.Line(x, y+2, x+width, y+2, style.Color)
+	}
+}
+
+type point struct{ x, y float64 }
+
+// fill paints the union of polygons, given in units of the layout.
+func (c *canvas) fill(polygons [][]point, col color.Color) {
+	min := point{math.Inf(1), math.Inf(1)}
+	max := point{math.Inf(-1), math.Inf(-1)}
+	for _, p := range polygons {
+		for _, q := range p {
+			min.x, min.y = math.Min(min.x, q.x), math.Min(min.y, q.y)
+			max.x, max.y = math.Max(max.x, q.x), math.Max(max.y, q.y)
+		}
+	}
+	// Rasterize only the bounding box of the shape.
+	r := image.Rect(
+		int(math.Floor(min.x*c.scale)), int(math.Floor(min.y*c.scale)),
+		int(math.Ceil(max.x*c.scale)), int(math.Ceil(max.y*c.scale)),
+	).Intersect(c.img.Bounds())
+	if r.Empty() {
+		return
+	}
+	z := vector.NewRasterizer(r.Dx(), r.Dy())
+	for _, p := range polygons {
+		for i, q := range p {
+			x := float32(q.x*c.scale) - float32(r.Min.X)
+			y := float32(q.y*c.scale) - float32(r.Min.Y)
+			if i == 0 {
+				z.MoveTo(x, y)
+			} else {
+				z.LineTo(x, y)
+			}
+		}
+		z.ClosePath()
+	}
+	z.Draw(c.img, r, image.NewUniform(col), image.Point{})
+}
+
+// segment returns a line one unit wide as a polygon. Its points always turn
+// the same way, so that the strokes of a path do not cancel where they
+// overlap.
+func segment(x1, y1, x2, y2 float64) [][]point {
+	dx, dy := x2-x1, y2-y1
+	length := math.Hypot(dx, dy)
+	if length == 0 {
+		return nil
+	}
+	nx, ny := -dy/length/2, dx/length/2
+	return [][]point{{
+		{x1 + nx, y1 + ny}, {x2 + nx, y2 + ny}, {x2 - nx, y2 - ny}, {x1 - nx, y1 - ny},
+	}}
+}
+
+func circle(cx, cy, r float64) [][]point {
+	const steps = 32
+	p := make([]point, steps)
+	for i := range p {
+		a := 2 * math.Pi * float64(i) / steps
+		p[i] = point{cx + r*math.Cos(a), cy + r*math.Sin(a)}
+	}
+	return [][]point{p}
+}
+
+func roundedRect(x, y, w, h, r float64) [][]point {
+	r = math.Max(0, math.Min(r, math.Min(w, h)/2))
+	if r == 0 {
+		return [][]point{{{x, y}, {x + w, y}, {x + w, y + h}, {x, y + h}}}
+	}
+	const steps = 8
+	var p []point
+	// The corners clockwise from the top right, with their centres.
+	corners := []point{{x + w - r, y + r}, {x + w - r, y + h - r}, {x + r, y + h - r}, {x + r, y + r}}
+	for i, c := range corners {
+		start := -math.Pi/2 + float64(i)*math.Pi/2
+		for j := 0; j <= steps; j++ {
+			a := start + float64(j)*math.Pi/2/steps
+			p = append(p, point{c.x + r*math.Cos(a), c.y + r*math.Sin(a)})
+		}
+	}
+	return [][]point{p}
+}
+
+// parseColor reads a #rgb, #rrggbb or #rrggbbaa color or an SVG color name,
+// which covers the usual Graphviz names. It returns def for other values.
+func parseColor(s string, def color.Color) color.Color {
+	if c, ok := colornames.Map[strings.ToLower(s)]; ok {
+		return c
+	}
+	if !strings.HasPrefix(s, "#") {
+		return def
+	}
+	hex := s[1:]
+	if len(hex) == 3 {
+		hex = string([]byte{hex[0], hex[0], hex[1], hex[1], hex[2], hex[2]})
+	}
+	if len(hex) == 6 {
+		hex += "ff"
+	}
+	if len(hex) != 8 {
+		return def
+	}
+	v, err := strconv.ParseUint(hex, 16, 32)
+	if err != nil {
+		return def
+	}
+	return color.NRGBA{R: uint8(v >> 24), G: uint8(v >> 16), B: uint8(v >> 8), A: uint8(v)}
+}
+
+func fixedToFloat(v fixed.Int26_6) float64 {
+	return float64(v) / 64
+}
+
+func floatToFixed(f float64) fixed.Int26_6 {
+	return fixed.Int26_6(math.Round(f * 64))
+}
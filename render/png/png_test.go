@@ -0,0 +1,85 @@
+package png
+
+import (
+	"bytes"
+	"image"
+	"image/color"
+	"image/png"
+	"testing"
+
+	"github.com/kaishuu0123/erd-go/erd"
+)
+
+func TestRender(t *testing.T) {
+	e, err := erd.ParseString("test.er", `[Person] {bgcolor: "#d0e0d0"}
+*name
++birth_location_id
+
+[Location]
+*id
+
+Person *--1 Location
+`)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	decode := func(dpi float64) image.Image {
+		var buf bytes.Buffer
+		if err := Render(&buf, e, dpi); err != nil {
+			t.Fatal(err)
+		}
+		img, err := png.Decode(&buf)
+		if err != nil {
+			t.Fatal(err)
+		}
+		return img
+	}
+
+	small, large := decode(96), decode(192)
+	sw, sh := small.Bounds().Dx(), small.Bounds().Dy()
+	lw, lh := large.Bounds().Dx(), large.Bounds().Dy()
+	if sw == 0 || sh == 0 {
+		t.Fatalf("empty image %dx%d", sw, sh)
+	}
+	if lw < 2*sw-1 || lw > 2*sw || lh < 2*sh-1 || lh > 2*sh {
+		t.Errorf("192 dpi image is %dx%d, want twice %dx%d", lw, lh, sw, sh)
+	}
+
+	// The background is white and the table is filled with its bgcolor.
+	if got := color.NRGBAModel.Convert(small.At(1, 1)); got != (color.NRGBA{0xff, 0xff, 0xff, 0xff}) {
+		t.Errorf("background is %v", got)
+	}
+	found := false
+	b := small.Bounds()
+	for y := b.Min.Y; y < b.Max.Y && !found; y++ {
+		for x := b.Min.X; x < b.Max.X && !found; x++ {
+			found = color.NRGBAModel.Convert(small.At(x, y)) == color.NRGBA{0xd0, 0xe0, 0xd0, 0xff}
+		}
+	}
+	if !found {
+		t.Error("no pixel has the bgcolor of Person")
+	}
+}
+
+func TestRender_invalidDPI(t *testing.T) {
+	var buf bytes.Buffer
+	if err := Render(&buf, &erd.Erd{}, 0); err == nil {
+		t.Error("no error for 0 dpi")
+	}
+}
+
+func TestParseColor(t *testing.T) {
+	for s, want := range map[string]color.Color{
+		"#d0e0d0":   color.NRGBA{0xd0, 0xe0, 0xd0, 0xff},
+		"#abc":      color.NRGBA{0xaa, 0xbb, 0xcc, 0xff},
+		"#11223344": color.NRGBA{0x11, 0x22, 0x33, 0x44},
+		"LightBlue": color.RGBA{0xad, 0xd8, 0xe6, 0xff},
+		"#zzz":      color.Black,
+		"nocolor":   color.Black,
+	} {
+		if got := parseColor(s, color.Black); got != want {
+			t.Errorf("parseColor(%q) = %v, want %v", s, got, want)
+		}
+	}
+}
@@ -0,0 +1,132 @@
+// Package render maps output format names to the renderers that produce
+// them.
+package render
+
+import (
+	"fmt"
+	"io"
+	"path/filepath"
+	"sort"
+	"text/template"
+
+	"github.com/kaishuu0123/erd-go/erd"
+	"github.com/kaishuu0123/erd-go/render/dot"
+	"github.com/kaishuu0123/erd-go/render/er"
+	"github.com/kaishuu0123/erd-go/render/mermaid"
+	"github.com/kaishuu0123/erd-go/render/plantuml"
+	"github.com/kaishuu0123/erd-go/render/png"
+	"github.com/kaishuu0123/erd-go/render/sql"
+	"github.com/kaishuu0123/erd-go/render/svg"
+	"github.com/kaishuu0123/erd-go/schema"
+)
+
+// Options holds the command line settings that only some formats use.
+type Options struct {
+	// Dialect is the SQL dialect written by the sql format.
+	Dialect string
+	// DPI is the resolution of the png format.
+	DPI float64
+	// TemplateDir is a directory of *.tmpl files parsed after the embedded
+	// templates of a template based format, to override or extend them.
+	TemplateDir string
+	// Template names the template to execute instead of the format's own.
+	Template string
+}
+
+// Func writes the diagram of e to w.
+type Func func(w io.Writer, e *erd.Erd, opts Options) error
+
+// plain adapts a renderer that takes no options.
+func plain(render func(io.Writer, *erd.Erd) error) Func {
+	return func(w io.Writer, e *erd.Erd, opts Options) error {
+		return render(w, e)
+	}
+}
+
+// templated returns the Func of a template based format. templates returns
+// the embedded set and root names the template to execute by default. bind,
+// if not nil, gives the functions of the set that depend on the model their
+// values for e.
+func templated(root string, templates func() *template.Template, bind func(*template.Template, *erd.Erd)) Func {
+	return func(w io.Writer, e *erd.Erd, opts Options) error {
+		t := templates()
+		if bind != nil {
+			bind(t, e)
+		}
+		if opts.TemplateDir != "" {
+			if _, err := t.ParseGlob(filepath.Join(opts.TemplateDir, "*.tmpl")); err != nil {
+				return err
+			}
+		}
+		name := root
+		if opts.Template != "" {
+			name = opts.Template
+		}
+		if t.Lookup(name) == nil {
+			return fmt.Errorf("template %q is not defined", name)
+		}
+		return t.ExecuteTemplate(w, name, e)
+	}
+}
+
+// Format is an output format selectable with --fmt.
+type Format struct {
+	Name        string
+	Description string
+	// Extension is the usual file name extension of the format, without
+	// the dot.
+	Extension string
+	Render    Func
+	// Templates returns the embedded templates of the format, or is nil
+	// when the format does not use templates.
+	Templates func() *template.Template
+	// Stubs tells whether the format draws the stub tables of a focused
+	// model, see erd.Table.Stub.
+	Stubs bool
+}
+
+var formats = map[string]*Format{}
+
+func init() {
+	Register(&Format{Name: "dot", Description: "Graphviz dot language", Extension: "dot", Render: templated(dot.Root, dot.Templates, nil), Templates: dot.Templates, Stubs: true})
+	Register(&Format{Name: "er", Description: "erd-go schema", Extension: "er", Render: plain(er.Render)})
+	Register(&Format{Name: "json", Description: "JSON document of the model, see package schema", Extension: "json", Render: plain(schema.RenderJSON)})
+	Register(&Format{Name: "mermaid", Description: "Mermaid erDiagram", Extension: "mmd", Render: templated(mermaid.Root, mermaid.Templates, mermaid.Bind), Templates: mermaid.Templates})
+	Register(&Format{Name: "png", Description: "PNG image, laid out without Graphviz, see --dpi", Extension: "png", Render: func(w io.Writer, e *erd.Erd, opts Options) error {
+		return png.Render(w, e, opts.DPI)
+	}, Stubs: true})
+	Register(&Format{Name: "plantuml", Description: "PlantUML entity diagram", Extension: "puml", Render: templated(plantuml.Root, plantuml.Templates, plantuml.Bind), Templates: plantuml.Templates})
+	Register(&Format{Name: "svg", Description: "SVG image, laid out without Graphviz", Extension: "svg", Render: plain(svg.Render), Stubs: true})
+	Register(&Format{Name: "yaml", Description: "YAML document of the model, see package schema", Extension: "yaml", Render: plain(schema.RenderYAML)})
+	Register(&Format{Name: "sql", Description: "SQL CREATE TABLE statements, see --dialect", Extension: "sql", Render: func(w io.Writer, e *erd.Erd, opts Options) error {
+		return sql.Render(w, e, opts.Dialect)
+	}})
+}
+
+// Register makes a format available by name. It panics if the name is
+// already taken.
+func Register(f *Format) {
+	if _, ok := formats[f.Name]; ok {
+		panic("render: format registered twice: " + f.Name)
+	}
+	formats[f.Name] = f
+}
+
+// Lookup returns the format called name.
+func Lookup(name string) (*Format, error) {
+	f, ok := formats[name]
+	if !ok {
+		return nil, fmt.Errorf("unknown output format %q (see --list-formats)", name)
+	}
+	return f, nil
+}
+
+// Formats returns every registered format sorted by name.
+func Formats() []*Format {
+	list := make([]*Format, 0, len(formats))
+	for _, f := range formats {
+		list = append(list, f)
+	}
+	sort.Slice(list, func(i, j int) bool { return list[i].Name < list[j].Name })
+	return list
+}
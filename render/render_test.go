@@ -0,0 +1,111 @@
+package render
+
+import (
+	"bytes"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/kaishuu0123/erd-go/erd"
+)
+
+func TestLookup(t *testing.T) {
+	f, err := Lookup("dot")
+	if err != nil {
+		t.Fatal(err)
+	}
+	e, err := erd.ParseString("test.er", "[Person]\nname\n")
+	if err != nil {
+		t.Fatal(err)
+	}
+	var buf bytes.Buffer
+	if err := f.Render(&buf, e, Options{}); err != nil {
+		t.Fatal(err)
+	}
+	if buf.Len() == 0 {
+		t.Error("dot renderer wrote nothing")
+	}
+
+	if _, err := Lookup("nope"); err == nil {
+		t.Error("Lookup(\"nope\") succeeded, want error")
+	}
+}
+
+func TestFormats(t *testing.T) {
+	list := Formats()
+	for i := 1; i < len(list); i++ {
+		if list[i-1].Name >= list[i].Name {
+			t.Errorf("formats not sorted: %q before %q", list[i-1].Name, list[i].Name)
+		}
+	}
+	for _, f := range list {
+		if f.Extension == "" || strings.HasPrefix(f.Extension, ".") {
+			t.Errorf("format %s has extension %q", f.Name, f.Extension)
+		}
+	}
+}
+
+func TestTemplateOptions(t *testing.T) {
+	dir, err := ioutil.TempDir("", "erd-templates")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(dir)
+
+	// Override a template of dot and add a new one.
+	files := map[string]string{
+		"tables.tmpl": `{{define "dot_tables"}}{{range .Tables}}  {{dotID .Title}};{{end}}{{end}}`,
+		"list.tmpl":   `{{define "list"}}{{range .Tables}}{{.Title}}: {{join (columnNames .Columns) ", "}}{{"\n"}}{{end}}{{end}}`,
+	}
+	for name, src := range files {
+		if err := ioutil.WriteFile(filepath.Join(dir, name), []byte(src), 0644); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	e, err := erd.ParseString("test.er", "[birth-place]\nid\ncity\n")
+	if err != nil {
+		t.Fatal(err)
+	}
+	f, err := Lookup("dot")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if f.Templates == nil {
+		t.Fatal("dot has no templates")
+	}
+
+	var buf bytes.Buffer
+	if err := f.Render(&buf, e, Options{TemplateDir: dir}); err != nil {
+		t.Fatal(err)
+	}
+	if !strings.Contains(buf.String(), `  "birth-place";`) || strings.Contains(buf.String(), "<TABLE") {
+		t.Errorf("dot_tables was not overridden:\n%s", buf.String())
+	}
+
+	buf.Reset()
+	if err := f.Render(&buf, e, Options{TemplateDir: dir, Template: "list"}); err != nil {
+		t.Fatal(err)
+	}
+	if want := "birth-place: id, city\n"; buf.String() != want {
+		t.Errorf("got %q, want %q", buf.String(), want)
+	}
+
+	// The embedded templates are left as they were.
+	buf.Reset()
+	if err := f.Render(&buf, e, Options{}); err != nil {
+		t.Fatal(err)
+	}
+	if !strings.Contains(buf.String(), "<TABLE") {
+		t.Errorf("embedded dot_tables changed:\n%s", buf.String())
+	}
+
+	if err := f.Render(&buf, e, Options{Template: "nope"}); err == nil {
+		t.Error("no error for an undefined template")
+	}
+	if err := f.Render(&buf, e, Options{TemplateDir: filepath.Join(dir, "missing")}); err == nil {
+		t.Error("no error for a directory without templates")
+	}
+}
@@ -0,0 +1,280 @@
+// Package sql renders an erd model as the CREATE TABLE statements of a SQL
+// database.
+package sql
+
+import (
+	"fmt"
+	"io"
+	"strings"
+
+	"github.com/kaishuu0123/erd-go/erd"
+	"github.com/kaishuu0123/erd-go/render/funcs"
+)
+
+// Dialects lists the names accepted by Render.
+var Dialects = []string{"postgres", "mysql", "sqlite"}
+
+type dialect struct {
+	quote       func(string) string
+	defaultType string
+	// alterTable is set when foreign keys to tables that are created later
+	// have to be added with ALTER TABLE. SQLite cannot do that but does not
+	// check the referenced table exists when the table is created.
+	alterTable bool
+}
+
+var dialects = map[string]*dialect{
+	"postgres": {quote: doubleQuote, defaultType: "text", alterTable: true},
+	"mysql":    {quote: backQuote, defaultType: "varchar(255)", alterTable: true},
+	"sqlite":   {quote: doubleQuote, defaultType: "text"},
+}
+
+func doubleQuote(s string) string {
+	return `"` + strings.Replace(s, `"`, `""`, -1) + `"`
+}
+
+func backQuote(s string) string {
+	return "`" + strings.Replace(s, "`", "``", -1) + "`"
+}
+
+// foreignKey is a FOREIGN KEY constraint of table on columns referencing
+// refColumns of refTable.
+type foreignKey struct {
+	table      *erd.Table
+	columns    []string
+	refTable   *erd.Table
+	refColumns []string
+}
+
+// Render writes the DDL of e in the given dialect to w. Tables are created
+// after the tables they reference.
+func Render(w io.Writer, e *erd.Erd, dialectName string) error {
+	d, ok := dialects[dialectName]
+	if !ok {
+		return fmt.Errorf("unknown SQL dialect %q (want one of %s)", dialectName, strings.Join(Dialects, ", "))
+	}
+
+	keys := map[*erd.Table][]*foreignKey{}
+	var notes []string
+	for _, r := range e.Relations {
+		fk, note := foreignKeyOf(e, r)
+		if fk == nil {
+			notes = append(notes, note)
+			continue
+		}
+		keys[fk.table] = append(keys[fk.table], fk)
+	}
+
+	ew := &funcs.ErrWriter{W: w}
+	for _, n := range notes {
+		ew.Printf("-- %s\n", n)
+	}
+	if len(notes) > 0 {
+		ew.Printf("\n")
+	}
+
+	created := map[*erd.Table]bool{}
+	var deferred []*foreignKey
+	for i, t := range createOrder(e.Tables, keys) {
+		if i > 0 {
+			ew.Printf("\n")
+		}
+		ew.Printf("CREATE TABLE %s (\n", d.quote(t.Title))
+		var lines []string
+		for _, c := range t.Columns {
+			lines = append(lines, "  "+columnDefinition(d, c))
+		}
+		if len(t.PrimaryKeys) > 0 {
+			var pks []string
+			for _, i := range t.PrimaryKeys {
+				pks = append(pks, t.Columns[i].Title)
+			}
+			lines = append(lines, "  PRIMARY KEY ("+quoteList(d, pks)+")")
+		}
+		created[t] = true
+		for _, fk := range keys[t] {
+			if d.alterTable && !created[fk.refTable] {
+				deferred = append(deferred, fk)
+				continue
+			}
+			lines = append(lines, "  "+foreignKeyClause(d, fk))
+		}
+		ew.Printf("%s\n);\n", strings.Join(lines, ",\n"))
+
+		for _, idx := range t.Indexes {
+			unique := ""
+			if idx.IsUnique {
+				unique = "UNIQUE "
+			}
+			ew.Printf("CREATE %sINDEX %s ON %s (%s);\n", unique, d.quote(idx.Title), d.quote(t.Title), quoteList(d, idx.Columns))
+		}
+	}
+
+	if len(deferred) > 0 {
+		ew.Printf("\n")
+	}
+	for _, fk := range deferred {
+		ew.Printf("ALTER TABLE %s ADD %s;\n", d.quote(fk.table.Title), foreignKeyClause(d, fk))
+	}
+	return ew.Err
+}
+
+func columnDefinition(d *dialect, c erd.Column) string {
+	typ := c.Type
+	if typ == "" {
+		typ = d.defaultType
+	}
+	def := d.quote(c.Title) + " " + typ
+	if c.IsNotNull || c.IsPrimaryKey {
+		def += " NOT NULL"
+	}
+	if c.Default != "" {
+		def += " DEFAULT " + c.Default
+	}
+	return def
+}
+
+func foreignKeyClause(d *dialect, fk *foreignKey) string {
+	return fmt.Sprintf("FOREIGN KEY (%s) REFERENCES %s (%s)",
+		quoteList(d, fk.columns), d.quote(fk.refTable.Title), quoteList(d, fk.refColumns))
+}
+
+func quoteList(d *dialect, names []string) string {
+	quoted := make([]string, len(names))
+	for i, n := range names {
+		quoted[i] = d.quote(n)
+	}
+	return strings.Join(quoted, ", ")
+}
+
+// createOrder sorts tables so that every table comes after the tables it
+// references, keeping declaration order where the references allow it.
+// Tables on a reference cycle are emitted in declaration order once nothing
+// else can be.
+func createOrder(tables []*erd.Table, keys map[*erd.Table][]*foreignKey) []*erd.Table {
+	done := map[*erd.Table]bool{}
+	ready := func(t *erd.Table) bool {
+		for _, fk := range keys[t] {
+			if fk.refTable != t && !done[fk.refTable] {
+				return false
+			}
+		}
+		return true
+	}
+
+	var order []*erd.Table
+	for len(order) < len(tables) {
+		next := -1
+		for i, t := range tables {
+			if !done[t] && ready(t) {
+				next = i
+				break
+			}
+		}
+		if next < 0 {
+			for i, t := range tables {
+				if !done[t] {
+					next = i
+					break
+				}
+			}
+		}
+		done[tables[next]] = true
+		order = append(order, tables[next])
+	}
+	return order
+}
+
+func isMany(cardinality string) bool {
+	return cardinality == "*" || cardinality == "+"
+}
+
+// foreignKeyOf works out the foreign key a relation stands for. The table
+// on the "many" side of the relation references the other one; for one to
+// one relations the optional side references the other, and otherwise the
+// left table does. Columns not named in the relation are found by matching
+// the referenced primary key against the referencing table's columns. When
+// no foreign key can be derived it returns a note saying why.
+func foreignKeyOf(e *erd.Erd, r erd.Relation) (*foreignKey, string) {
+	desc := fmt.Sprintf("relation %s %s--%s %s", r.LeftTableName, r.LeftCardinality, r.RightCardinality, r.RightTableName)
+	left, right := e.Table(r.LeftTableName), e.Table(r.RightTableName)
+	if left == nil || right == nil {
+		return nil, desc + ": undefined table"
+	}
+
+	from, fromColumn, to, toColumn := left, r.LeftColumn, right, r.RightColumn
+	switch {
+	case isMany(r.LeftCardinality) && isMany(r.RightCardinality):
+		return nil, desc + ": many to many relations need a join table"
+	case isMany(r.RightCardinality),
+		!isMany(r.LeftCardinality) && r.RightCardinality == "0" && r.LeftCardinality != "0":
+		from, fromColumn, to, toColumn = right, r.RightColumn, left, r.LeftColumn
+	}
+
+	fk := &foreignKey{table: from, refTable: to}
+	if toColumn != "" {
+		fk.refColumns = []string{toColumn}
+	} else {
+		for _, i := range to.PrimaryKeys {
+			fk.refColumns = append(fk.refColumns, to.Columns[i].Title)
+		}
+	}
+	if len(fk.refColumns) == 0 {
+		return nil, desc + ": " + to.Title + " has no primary key"
+	}
+
+	if fromColumn != "" {
+		if len(fk.refColumns) != 1 {
+			return nil, desc + ": " + to.Title + " has a composite primary key, name it in the relation"
+		}
+		fk.columns = []string{fromColumn}
+		return fk, ""
+	}
+	for _, ref := range fk.refColumns {
+		c := matchColumn(from, to, ref, len(fk.refColumns) == 1)
+		if c == "" {
+			return nil, desc + ": no column of " + from.Title + " matches " + to.Title + "." + ref
+		}
+		fk.columns = append(fk.columns, c)
+	}
+	return fk, ""
+}
+
+// matchColumn finds the column of from that holds column ref of to. It looks
+// for a column named ref or to_ref, or just to when ref is to's only key,
+// ignoring case. A foreign key column whose name ends in _to_ref, such as
+// birth_location_id for location.id, matches too. Columns marked as foreign
+// keys are preferred and columns that are only from's own primary key are
+// never used.
+func matchColumn(from, to *erd.Table, ref string, single bool) string {
+	names := []string{to.Title + "_" + ref}
+	if from != to {
+		names = append([]string{ref}, names...)
+	}
+	if single {
+		names = append(names, to.Title)
+	}
+	suffix := strings.ToLower("_" + to.Title + "_" + ref)
+
+	match := ""
+	for _, c := range from.Columns {
+		if c.IsPrimaryKey && !c.IsForeignKey {
+			continue
+		}
+		for _, n := range names {
+			if !strings.EqualFold(c.Title, n) {
+				continue
+			}
+			if c.IsForeignKey {
+				return c.Title
+			}
+			if match == "" {
+				match = c.Title
+			}
+		}
+		if c.IsForeignKey && strings.HasSuffix(strings.ToLower(c.Title), suffix) && match == "" {
+			match = c.Title
+		}
+	}
+	return match
+}
@@ -0,0 +1,118 @@
+package sql
+
+import (
+	"bytes"
+	"testing"
+
+	"github.com/kaishuu0123/erd-go/erd"
+)
+
+const schema = `[Person]
+*id int
+name varchar(64) not null default 'x'
++location_id int
++mother_id int
+index person_name (name) {unique: true}
+
+[Location]
+*id int
+city
+
+[Visit]
+*+person_id int
+*+location_id int
+
+Person *--1 Location
+Person.mother_id *--0 Person.id
+Visit *--1 Person
+Location 1--* Visit
+Person *--* Location
+`
+
+func TestRender(t *testing.T) {
+	e, err := erd.ParseString("test.er", schema)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	for _, test := range []struct {
+		dialect string
+		want    string
+	}{
+		{"postgres", `-- relation Person *--* Location: many to many relations need a join table
+
+CREATE TABLE "Location" (
+  "id" int NOT NULL,
+  "city" text,
+  PRIMARY KEY ("id")
+);
+
+CREATE TABLE "Person" (
+  "id" int NOT NULL,
+  "name" varchar(64) NOT NULL DEFAULT 'x',
+  "location_id" int,
+  "mother_id" int,
+  PRIMARY KEY ("id"),
+  FOREIGN KEY ("location_id") REFERENCES "Location" ("id"),
+  FOREIGN KEY ("mother_id") REFERENCES "Person" ("id")
+);
+CREATE UNIQUE INDEX "person_name" ON "Person" ("name");
+
+CREATE TABLE "Visit" (
+  "person_id" int NOT NULL,
+  "location_id" int NOT NULL,
+  PRIMARY KEY ("person_id", "location_id"),
+  FOREIGN KEY ("person_id") REFERENCES "Person" ("id"),
+  FOREIGN KEY ("location_id") REFERENCES "Location" ("id")
+);
+`},
+		{"mysql", "-- relation Person *--* Location: many to many relations need a join table\n\n" +
+			"CREATE TABLE `Location` (\n" +
+			"  `id` int NOT NULL,\n" +
+			"  `city` varchar(255),\n" +
+			"  PRIMARY KEY (`id`)\n" +
+			");\n"},
+	} {
+		var buf bytes.Buffer
+		if err := Render(&buf, e, test.dialect); err != nil {
+			t.Fatal(err)
+		}
+		if got := buf.String(); len(got) < len(test.want) || got[:len(test.want)] != test.want {
+			t.Errorf("%s: got:\n%s\nwant prefix:\n%s", test.dialect, got, test.want)
+		}
+	}
+}
+
+func TestRender_cycle(t *testing.T) {
+	e, err := erd.ParseString("test.er", "[A]\n*id\n+b_id\n[B]\n*id\n+a_id\nA *--1 B\nB *--1 A\n")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	var buf bytes.Buffer
+	if err := Render(&buf, e, "postgres"); err != nil {
+		t.Fatal(err)
+	}
+	want := `CREATE TABLE "A" (
+  "id" text NOT NULL,
+  "b_id" text,
+  PRIMARY KEY ("id")
+);
+
+CREATE TABLE "B" (
+  "id" text NOT NULL,
+  "a_id" text,
+  PRIMARY KEY ("id"),
+  FOREIGN KEY ("a_id") REFERENCES "A" ("id")
+);
+
+ALTER TABLE "A" ADD FOREIGN KEY ("b_id") REFERENCES "B" ("id");
+`
+	if buf.String() != want {
+		t.Errorf("got:\n%s\nwant:\n%s", buf.String(), want)
+	}
+
+	if err := Render(&buf, e, "oracle"); err == nil {
+		t.Error("Render with an unknown dialect succeeded, want error")
+	}
+}
@@ -0,0 +1,122 @@
+// Package svg draws an erd model as an SVG image, for when Graphviz is not
+// available.
+package svg
+
+import (
+	"bytes"
+	"encoding/xml"
+	"fmt"
+	"io"
+	"strings"
+
+	"github.com/kaishuu0123/erd-go/erd"
+	"github.com/kaishuu0123/erd-go/render/diagram"
+	"github.com/kaishuu0123/erd-go/render/funcs"
+)
+
+const fontFamily = "Helvetica, Arial, sans-serif"
+
+// Render writes the diagram of e to w as an SVG document. The text is
+// measured from the proportions of a sans-serif font, as the font that
+// displays the image is not known.
+func Render(w io.Writer, e *erd.Erd) error {
+	l := diagram.New(e, diagram.EstimateWidth)
+	c := &canvas{ErrWriter: funcs.ErrWriter{W: w}}
+
+	c.Printf(`<?xml version="1.0" encoding="UTF-8"?>` + "\n")
+	c.Printf(`<svg xmlns="http://www.w3.org/2000/svg" width="%s" height="%s" viewBox="0 0 %s %s" font-family="%s">`+"\n",
+		num(l.Width), num(l.Height), num(l.Width), num(l.Height), fontFamily)
+	l.Draw(c)
+	c.Printf("</svg>\n")
+	return c.Err
+}
+
+// canvas writes the shapes as SVG elements.
+type canvas struct {
+	funcs.ErrWriter
+	indent string
+}
+
+func (c *canvas) Begin(class, id string) {
+	if id == "" {
+		c.Printf("%s<g class=\"%s\">\n", c.indent, esc(class))
+	} else {
+		c.Printf("%s<g class=\"%s\" id=\"%s\">\n", c.indent, esc(class), esc(id))
+	}
+	c.indent += "  "
+}
+
+func (c *canvas) End() {
+	c.indent = c.indent[:len(c.indent)-2]
+	c.Printf("%s</g>\n", c.indent)
+}
+
+func (c *canvas) Rect(x, y, w, h, radius float64, fill, stroke string) {
+	c.Printf(`%s<rect x="%s" y="%s" width="%s" height="%s"`, c.indent, num(x), num(y), num(w), num(h))
+	if radius > 0 {
+		c.Printf(` rx="%s"`, num(radius))
+	}
+	c.Printf(` fill="%s"%s/>`+"\n", esc(fill), strokeAttr(stroke))
+}
+
+func (c *canvas) Line(x1, y1, x2, y2 float64, stroke string) {
+	c.Printf(`%s<line x1="%s" y1="%s" x2="%s" y2="%s"%s/>`+"\n", c.indent, num(x1), num(y1), num(x2), num(y2), strokeAttr(stroke))
+}
+
+func (c *canvas) Circle(cx, cy, r float64, fill, stroke string) {
+	c.Printf(`%s<circle cx="%s" cy="%s" r="%s" fill="%s"%s/>`+"\n", c.indent, num(cx), num(cy), num(r), esc(fill), strokeAttr(stroke))
+}
+
+func (c *canvas) Curve(x1, y1, c1x, c1y, c2x, c2y, x2, y2 float64, stroke string) {
+	c.Printf(`%s<path d="M%s,%s C%s,%s %s,%s %s,%s" fill="none"%s/>`+"\n", c.indent,
+		num(x1), num(y1), num(c1x), num(c1y), num(c2x), num(c2y), num(x2), num(y2), strokeAttr(stroke))
+}
+
+func (c *canvas) Text(x, y float64, s string, style diagram.TextStyle) {
+	c.Printf(`%s<text x="%s" y="%s" font-size="%s"`, c.indent, num(x), num(y), num(style.Size))
+	if style.Bold {
+		c.Printf(` font-weight="bold"`)
+	}
+	if style.Italic {
+		c.Printf(` font-style="italic"`)
+	}
+	if style.Underline {
+		c.Printf(` text-decoration="underline"`)
+	}
+	if style.Color != "" {
+		c.Printf(` fill="%s"`, esc(style.Color))
+	}
+	if style.Anchor == diagram.AnchorMiddle {
+		c.Printf(` text-anchor="middle"`)
+	}
+	if strings.TrimSpace(s) != s || strings.Contains(s, "  ") {
+		// Keep spaces such as the one between a column name and its label.
+		c.Printf(` xml:space="preserve"`)
+	}
+	c.Printf(`>%s</text>`+"\n", esc(s))
+}
+
+func strokeAttr(stroke string) string {
+	if stroke == "" {
+		return ""
+	}
+	return ` stroke="` + esc(stroke) + `"`
+}
+
+// num formats a coordinate with at most one decimal.
+func num(f float64) string {
+	s := fmt.Sprintf("%.1f", f)
+	if len(s) > 2 && s[len(s)-2:] == ".0" {
+		s = s[:len(s)-2]
+	}
+	if s == "-0" {
+		s = "0"
+	}
+	return s
+}
+
+func esc(s string) string {
+	var b bytes.Buffer
+	xml.EscapeText(&b, []byte(s))
+	return b.String()
+}
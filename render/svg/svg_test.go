@@ -0,0 +1,108 @@
+package svg
+
+import (
+	"bytes"
+	"encoding/xml"
+	"io"
+	"strings"
+	"testing"
+
+	"github.com/kaishuu0123/erd-go/erd"
+)
+
+const src = `title {label: "People & places"}
+
+[Person] {bgcolor: "#fcecec"}
+*name varchar(64) {label: "full name"}
++birth_location_id int not null
+index person_name (name) {unique: true}
+
+[Location]
+*id
+city
+
+Person *--1 Location {label: "born in"}
+Person 0--1 Person
+`
+
+func TestRender(t *testing.T) {
+	e, err := erd.ParseString("test.er", src)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	var buf bytes.Buffer
+	if err := Render(&buf, e); err != nil {
+		t.Fatal(err)
+	}
+
+	// The document must be well formed.
+	d := xml.NewDecoder(bytes.NewReader(buf.Bytes()))
+	var texts []string
+	for {
+		tok, err := d.Token()
+		if err == io.EOF {
+			break
+		}
+		if err != nil {
+			t.Fatalf("invalid SVG: %v\n%s", err, buf.String())
+		}
+		if data, ok := tok.(xml.CharData); ok && strings.TrimSpace(string(data)) != "" {
+			texts = append(texts, string(data))
+		}
+	}
+
+	for _, want := range []string{"People & places", "Person", "Location", "name", " full name", "varchar(64)", "not null", "unique person_name (name)", "born in"} {
+		found := false
+		for _, text := range texts {
+			if text == want {
+				found = true
+			}
+		}
+		if !found {
+			t.Errorf("no text %q in %q", want, texts)
+		}
+	}
+	for _, want := range []string{`id="table-Person"`, `fill="#fcecec"`, `text-decoration="underline"`, `class="relation"`} {
+		if !strings.Contains(buf.String(), want) {
+			t.Errorf("output lacks %s", want)
+		}
+	}
+
+	var again bytes.Buffer
+	if err := Render(&again, e); err != nil {
+		t.Fatal(err)
+	}
+	if again.String() != buf.String() {
+		t.Error("output differs between runs")
+	}
+}
+
+func TestRender_stub(t *testing.T) {
+	e, err := erd.ParseString("test.er", src)
+	if err != nil {
+		t.Fatal(err)
+	}
+	l := e.Table("Location")
+	l.Stub, l.Columns, l.PrimaryKeys = true, nil, nil
+
+	var buf bytes.Buffer
+	if err := Render(&buf, e); err != nil {
+		t.Fatal(err)
+	}
+	out := buf.String()
+	i := strings.Index(out, `id="table-Location"`)
+	if i < 0 {
+		t.Fatalf("no Location table in\n%s", out)
+	}
+	stub := out[i:]
+	stub = stub[:strings.Index(stub, "</g>")]
+	for _, want := range []string{`stroke="#999999"`, `font-style="italic" fill="#666666" text-anchor="middle">Location<`} {
+		if !strings.Contains(stub, want) {
+			t.Errorf("stub lacks %s:\n%s", want, stub)
+		}
+	}
+	if strings.Contains(stub, ">city<") {
+		t.Errorf("stub has columns:\n%s", stub)
+	}
+}
@@ -0,0 +1,299 @@
+// Package schema defines the JSON and YAML representation of an erd model,
+// so that other tools can read the parsed schema and hand back models of
+// their own.
+//
+// A document looks like this in JSON; YAML uses the same keys:
+//
+//	{
+//	  "version": 1,
+//	  "title": {"label": "People"},
+//	  "groups": [{"name": "Core", "attributes": {"bgcolor": "#ececfc"}}],
+//	  "tables": [
+//	    {
+//	      "name": "Person",
+//	      "attributes": {"group": "Core"},
+//	      "columns": [
+//	        {"name": "id", "type": "int", "notNull": true, "primaryKey": true},
+//	        {"name": "location_id", "foreignKey": true, "attributes": {"label": "born in"}}
+//	      ],
+//	      "indexes": [{"name": "person_location", "columns": ["location_id"], "unique": false}]
+//	    }
+//	  ],
+//	  "relations": [
+//	    {
+//	      "left": {"table": "Person", "column": "location_id", "cardinality": "*"},
+//	      "right": {"table": "Location", "cardinality": "1"},
+//	      "attributes": {"label": "born in"}
+//	    }
+//	  ]
+//	}
+//
+// Groups are the ones declared with a group line; tables join a group with
+// their group attribute. Groups, tables, columns, indexes and relations
+// keep the order of the schema.
+// Attributes are the free form key/value pairs of the .er format. Fields
+// that are empty or false may be left out. schema.json in this directory
+// is the JSON Schema of the format.
+package schema
+
+import (
+	"encoding/json"
+	"io"
+	"regexp"
+	"strconv"
+	"strings"
+	"unicode/utf8"
+
+	"github.com/kaishuu0123/erd-go/erd"
+	yaml "gopkg.in/yaml.v2"
+)
+
+// Version is the version of the format written by this package. Documents
+// of other versions are rejected.
+const Version = 1
+
+type Document struct {
+	Version   int               `json:"version" yaml:"version"`
+	Title     map[string]string `json:"title,omitempty" yaml:"title,omitempty"`
+	Groups    []Group           `json:"groups,omitempty" yaml:"groups,omitempty"`
+	Tables    []Table           `json:"tables" yaml:"tables"`
+	Relations []Relation        `json:"relations" yaml:"relations"`
+}
+
+type Group struct {
+	Name       string            `json:"name" yaml:"name"`
+	Attributes map[string]string `json:"attributes,omitempty" yaml:"attributes,omitempty"`
+}
+
+type Table struct {
+	Name       string            `json:"name" yaml:"name"`
+	Attributes map[string]string `json:"attributes,omitempty" yaml:"attributes,omitempty"`
+	Columns    []Column          `json:"columns" yaml:"columns"`
+	Indexes    []Index           `json:"indexes,omitempty" yaml:"indexes,omitempty"`
+}
+
+type Column struct {
+	Name       string            `json:"name" yaml:"name"`
+	Type       string            `json:"type,omitempty" yaml:"type,omitempty"`
+	NotNull    bool              `json:"notNull,omitempty" yaml:"notNull,omitempty"`
+	Default    string            `json:"default,omitempty" yaml:"default,omitempty"`
+	PrimaryKey bool              `json:"primaryKey,omitempty" yaml:"primaryKey,omitempty"`
+	ForeignKey bool              `json:"foreignKey,omitempty" yaml:"foreignKey,omitempty"`
+	Attributes map[string]string `json:"attributes,omitempty" yaml:"attributes,omitempty"`
+}
+
+type Index struct {
+	Name       string            `json:"name" yaml:"name"`
+	Columns    []string          `json:"columns" yaml:"columns"`
+	Unique     bool              `json:"unique" yaml:"unique"`
+	Attributes map[string]string `json:"attributes,omitempty" yaml:"attributes,omitempty"`
+}
+
+type Relation struct {
+	Left       Endpoint          `json:"left" yaml:"left"`
+	Right      Endpoint          `json:"right" yaml:"right"`
+	Attributes map[string]string `json:"attributes,omitempty" yaml:"attributes,omitempty"`
+}
+
+// Endpoint is one side of a relation. Column is optional and Cardinality
+// is one of "0", "1", "*" and "+" as in the .er format.
+type Endpoint struct {
+	Table       string `json:"table" yaml:"table"`
+	Column      string `json:"column,omitempty" yaml:"column,omitempty"`
+	Cardinality string `json:"cardinality" yaml:"cardinality"`
+}
+
+// New returns the document of e.
+func New(e *erd.Erd) *Document {
+	d := &Document{Version: Version, Title: e.Title.TitleAttributes, Tables: []Table{}, Relations: []Relation{}}
+	for _, g := range e.Groups {
+		if g.Pos >= 0 {
+			d.Groups = append(d.Groups, Group{Name: g.Title, Attributes: g.GroupAttributes})
+		}
+	}
+	for _, t := range e.Tables {
+		table := Table{Name: t.Title, Attributes: t.TableAttributes, Columns: []Column{}}
+		for _, c := range t.Columns {
+			table.Columns = append(table.Columns, Column{
+				Name:       c.Title,
+				Type:       c.Type,
+				NotNull:    c.IsNotNull,
+				Default:    c.Default,
+				PrimaryKey: c.IsPrimaryKey,
+				ForeignKey: c.IsForeignKey,
+				Attributes: c.ColumnAttributes,
+			})
+		}
+		for _, idx := range t.Indexes {
+			// unique is a field of its own.
+			attrs := map[string]string{}
+			for k, v := range idx.IndexAttributes {
+				if k != "unique" {
+					attrs[k] = v
+				}
+			}
+			table.Indexes = append(table.Indexes, Index{Name: idx.Title, Columns: idx.Columns, Unique: idx.IsUnique, Attributes: attrs})
+		}
+		d.Tables = append(d.Tables, table)
+	}
+	for _, r := range e.Relations {
+		d.Relations = append(d.Relations, Relation{
+			Left:       Endpoint{Table: r.LeftTableName, Column: r.LeftColumn, Cardinality: r.LeftCardinality},
+			Right:      Endpoint{Table: r.RightTableName, Column: r.RightColumn, Cardinality: r.RightCardinality},
+			Attributes: r.RelationAttributes,
+		})
+	}
+	return d
+}
+
+// Erd builds the model of d. Problems such as undefined tables are reported
+// as diagnostics of the model; they have no position.
+func (d *Document) Erd() *erd.Erd {
+	e := &erd.Erd{}
+	if d.Version != Version {
+		e.Errorf(0, 0, "unsupported schema version %d, want %d", d.Version, Version)
+		return e
+	}
+	e.Title.TitleAttributes = d.Title
+
+	for _, g := range d.Groups {
+		if e.Group(g.Name) != nil {
+			e.Errorf(0, 0, "group %q is already declared", g.Name)
+			continue
+		}
+		e.NewGroup(g.Name, g.Attributes, 0)
+	}
+
+	for _, t := range d.Tables {
+		if e.Table(t.Name) != nil {
+			e.Errorf(0, 0, "table %q is already declared", t.Name)
+			continue
+		}
+		table := e.NewTable(t.Name, t.Attributes, 0)
+		for _, c := range t.Columns {
+			table.AppendColumn(erd.Column{
+				Title:            c.Name,
+				Type:             c.Type,
+				IsNotNull:        c.NotNull,
+				Default:          c.Default,
+				IsPrimaryKey:     c.PrimaryKey,
+				IsForeignKey:     c.ForeignKey,
+				ColumnAttributes: c.Attributes,
+			})
+		}
+		for _, idx := range t.Indexes {
+			table.AppendIndex(erd.Index{Title: idx.Name, Columns: idx.Columns, IsUnique: idx.Unique, IndexAttributes: idx.Attributes}, nil)
+		}
+	}
+
+	for _, r := range d.Relations {
+		for _, end := range []Endpoint{r.Left, r.Right} {
+			if !strings.Contains("01*+", end.Cardinality) || len(end.Cardinality) != 1 {
+				e.Errorf(0, 0, "invalid cardinality %q of the relation between %q and %q", end.Cardinality, r.Left.Table, r.Right.Table)
+			}
+		}
+		e.Relations = append(e.Relations, erd.Relation{
+			LeftTableName:      r.Left.Table,
+			LeftColumn:         r.Left.Column,
+			LeftCardinality:    r.Left.Cardinality,
+			RightTableName:     r.Right.Table,
+			RightColumn:        r.Right.Column,
+			RightCardinality:   r.Right.Cardinality,
+			RelationAttributes: r.Attributes,
+		})
+	}
+	return e
+}
+
+// RenderJSON writes e to w as an indented JSON document.
+func RenderJSON(w io.Writer, e *erd.Erd) error {
+	enc := json.NewEncoder(w)
+	enc.SetEscapeHTML(false)
+	enc.SetIndent("", "  ")
+	return enc.Encode(New(e))
+}
+
+// RenderYAML writes e to w as a YAML document.
+func RenderYAML(w io.Writer, e *erd.Erd) error {
+	out, err := yaml.Marshal(New(e))
+	if err != nil {
+		return err
+	}
+	_, err = w.Write(out)
+	return err
+}
+
+// ParseJSON builds the model of the JSON document src. Like
+// erd.ParseString it returns the model along with an erd.ErrorList when src
+// is invalid; syntax errors are located in src.
+func ParseJSON(name string, src string) (*erd.Erd, error) {
+	var d Document
+	dec := json.NewDecoder(strings.NewReader(src))
+	dec.DisallowUnknownFields()
+	if err := dec.Decode(&d); err != nil {
+		e := &erd.Erd{}
+		pos := 0
+		switch err := err.(type) {
+		case *json.SyntaxError:
+			// Offset counts the offending byte as read.
+			offset := err.Offset - 1
+			if offset < 0 {
+				offset = 0
+			}
+			pos = runeOffset(src, offset)
+		case *json.UnmarshalTypeError:
+			pos = runeOffset(src, err.Offset)
+		}
+		e.Errorf(pos, pos, "%v", err)
+		e.Validate(name, src)
+		return e, e.Errors()
+	}
+	return build(name, src, &d)
+}
+
+var yamlLine = regexp.MustCompile(`^yaml: line (\d+):`)
+
+// ParseYAML is like ParseJSON for YAML documents.
+func ParseYAML(name string, src string) (*erd.Erd, error) {
+	var d Document
+	if err := yaml.UnmarshalStrict([]byte(src), &d); err != nil {
+		e := &erd.Erd{}
+		pos := 0
+		if m := yamlLine.FindStringSubmatch(err.Error()); m != nil {
+			line, _ := strconv.Atoi(m[1])
+			pos = lineOffset(src, line)
+		}
+		e.Errorf(pos, pos, "%v", err)
+		e.Validate(name, src)
+		return e, e.Errors()
+	}
+	return build(name, src, &d)
+}
+
+func build(name string, src string, d *Document) (*erd.Erd, error) {
+	e := d.Erd()
+	e.Validate(name, src)
+	return e, e.Errors()
+}
+
+// lineOffset returns the rune offset of the start of the 1-based line n.
+func lineOffset(src string, n int) int {
+	pos := 0
+	for _, r := range src {
+		if n <= 1 {
+			break
+		}
+		if r == '\n' {
+			n--
+		}
+		pos++
+	}
+	return pos
+}
+
+func runeOffset(src string, offset int64) int {
+	if offset > int64(len(src)) {
+		offset = int64(len(src))
+	}
+	return utf8.RuneCountInString(src[:offset])
+}
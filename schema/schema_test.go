@@ -0,0 +1,165 @@
+package schema
+
+import (
+	"bytes"
+	"io/ioutil"
+	"path/filepath"
+	"reflect"
+	"strings"
+	"testing"
+
+	"github.com/kaishuu0123/erd-go/erd"
+)
+
+func TestRoundTrip(t *testing.T) {
+	for _, name := range []string{"simple", "nfldb"} {
+		src, err := ioutil.ReadFile(filepath.Join("..", "examples", name+".er"))
+		if err != nil {
+			t.Fatal(err)
+		}
+		e, err := erd.ParseString(name, string(src))
+		if err != nil {
+			t.Fatal(err)
+		}
+		want := New(e)
+
+		for _, f := range []struct {
+			format string
+			render func(*bytes.Buffer, *erd.Erd) error
+			parse  func(string, string) (*erd.Erd, error)
+		}{
+			{"json", func(b *bytes.Buffer, e *erd.Erd) error { return RenderJSON(b, e) }, ParseJSON},
+			{"yaml", func(b *bytes.Buffer, e *erd.Erd) error { return RenderYAML(b, e) }, ParseYAML},
+		} {
+			var buf bytes.Buffer
+			if err := f.render(&buf, e); err != nil {
+				t.Fatal(err)
+			}
+			back, err := f.parse(name+"."+f.format, buf.String())
+			if err != nil {
+				t.Fatalf("%s.%s: %v\n%s", name, f.format, err, buf.String())
+			}
+			if got := New(back); !reflect.DeepEqual(got, want) {
+				t.Errorf("%s.%s: model changed in the round trip:\n%+v\nwant:\n%+v", name, f.format, got, want)
+			}
+		}
+	}
+}
+
+func TestRoundTrip_groups(t *testing.T) {
+	e, err := erd.ParseString("test.er", `group "Core area" {bgcolor: "#ececfc"}
+[Person] {group: "Core area"}
+*id
+[Location] {group: Places}
+*id
+`)
+	if err != nil {
+		t.Fatal(err)
+	}
+	var buf bytes.Buffer
+	if err := RenderJSON(&buf, e); err != nil {
+		t.Fatal(err)
+	}
+	if !strings.Contains(buf.String(), `"groups": [
+    {
+      "name": "Core area",`) || strings.Contains(buf.String(), `"name": "Places"`) {
+		t.Errorf("groups are not written as declared:\n%s", buf.String())
+	}
+
+	back, err := ParseJSON("test.json", buf.String())
+	if err != nil {
+		t.Fatal(err)
+	}
+	var got []string
+	for _, g := range back.Groups {
+		got = append(got, g.Title+" "+g.GroupAttributes["bgcolor"]+" "+g.Tables[0].Title)
+	}
+	if want := []string{"Core area #ececfc Person", "Places  Location"}; !reflect.DeepEqual(got, want) {
+		t.Errorf("got: %q\nwant: %q", got, want)
+	}
+}
+
+func TestRenderJSON(t *testing.T) {
+	e, err := erd.ParseString("test.er", "[Person]\n*id int not null\nindex person_id (id) {unique: true}\n[Location]\n*id\nPerson *--1 Location\n")
+	if err != nil {
+		t.Fatal(err)
+	}
+	var buf bytes.Buffer
+	if err := RenderJSON(&buf, e); err != nil {
+		t.Fatal(err)
+	}
+	want := `{
+  "version": 1,
+  "tables": [
+    {
+      "name": "Person",
+      "columns": [
+        {
+          "name": "id",
+          "type": "int",
+          "notNull": true,
+          "primaryKey": true
+        }
+      ],
+      "indexes": [
+        {
+          "name": "person_id",
+          "columns": [
+            "id"
+          ],
+          "unique": true
+        }
+      ]
+    },
+    {
+      "name": "Location",
+      "columns": [
+        {
+          "name": "id",
+          "primaryKey": true
+        }
+      ]
+    }
+  ],
+  "relations": [
+    {
+      "left": {
+        "table": "Person",
+        "cardinality": "*"
+      },
+      "right": {
+        "table": "Location",
+        "cardinality": "1"
+      }
+    }
+  ]
+}
+`
+	if buf.String() != want {
+		t.Errorf("got:\n%s\nwant:\n%s", buf.String(), want)
+	}
+}
+
+func TestParse_errors(t *testing.T) {
+	for _, test := range []struct {
+		parse func(string, string) (*erd.Erd, error)
+		src   string
+		want  string
+	}{
+		{ParseJSON, "{\n  \"version\": 1,\n  \"tables\": [}\n", "test:3:14: error: invalid character '}' looking for beginning of value"},
+		{ParseJSON, `{"version": 2, "tables": [], "relations": []}`, "test:1:1: error: unsupported schema version 2, want 1"},
+		{ParseJSON, `{"version": 1, "tablez": []}`, `test:1:1: error: json: unknown field "tablez"`},
+		{ParseYAML, "version: 1\ntables: [\n", "test:2:1: error: yaml: line 2: did not find expected node content"},
+		{ParseYAML, "version: 1\ntables: []\nrelations:\n- left: {table: A, cardinality: '*'}\n  right: {table: B, cardinality: '2'}\n",
+			`test:1:1: error: invalid cardinality "2" of the relation between "A" and "B"`},
+	} {
+		_, err := test.parse("test", test.src)
+		if err == nil {
+			t.Errorf("%q: no error, want %s", test.src, test.want)
+			continue
+		}
+		if got := err.(erd.ErrorList)[0].Error(); !strings.HasPrefix(got, test.want) {
+			t.Errorf("%q: got %s, want %s", test.src, got, test.want)
+		}
+	}
+}
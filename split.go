@@ -0,0 +1,59 @@
+package main
+
+import (
+	"bytes"
+	"fmt"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"strings"
+
+	"github.com/kaishuu0123/erd-go/erd"
+	"github.com/kaishuu0123/erd-go/filter"
+	"github.com/kaishuu0123/erd-go/render"
+)
+
+// writeSplit splits model by group or component, as given by by, and writes
+// a file per part into the dir directory, along with an index.md linking
+// them.
+func writeSplit(dir string, model *erd.Erd, by string, format *render.Format, ropts render.Options) error {
+	parts, err := filter.Split(model, by)
+	if err != nil {
+		return err
+	}
+	if err := os.MkdirAll(dir, 0755); err != nil {
+		return err
+	}
+
+	var index bytes.Buffer
+	title := model.Title.TitleAttributes["label"]
+	if title == "" {
+		title = "Schema"
+	}
+	fmt.Fprintf(&index, "# %s\n\n", markdownEscaper.Replace(title))
+	for _, p := range parts {
+		var buf bytes.Buffer
+		if err := format.Render(&buf, p.Erd, ropts); err != nil {
+			return err
+		}
+		name := p.Name + "." + format.Extension
+		if err := ioutil.WriteFile(filepath.Join(dir, name), buf.Bytes(), 0644); err != nil {
+			return err
+		}
+		tables := make([]string, len(p.Erd.Tables))
+		for i, t := range p.Erd.Tables {
+			tables[i] = t.Title
+		}
+		fmt.Fprintf(&index, "- [%s](%s): %s\n", markdownEscaper.Replace(p.Title), name, markdownEscaper.Replace(strings.Join(tables, ", ")))
+	}
+	return ioutil.WriteFile(filepath.Join(dir, "index.md"), index.Bytes(), 0644)
+}
+
+var markdownEscaper = strings.NewReplacer(
+	`\`, `\\`,
+	"[", `\[`,
+	"]", `\]`,
+	"*", `\*`,
+	"_", `\_`,
+	"`", "\\`",
+)